@@ -35,7 +35,7 @@ var file_exam_api_v1_exam_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61,
 	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc6, 0x08, 0x0a, 0x0b, 0x45, 0x78, 0x61, 0x6d, 0x53,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xbb, 0x16, 0x0a, 0x0b, 0x45, 0x78, 0x61, 0x6d, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x09, 0x45, 0x78, 0x61, 0x6d, 0x4c,
 	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
@@ -45,110 +45,265 @@ var file_exam_api_v1_exam_proto_rawDesc = []byte{
 	0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0f, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0xab,
 	0xaf, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
 	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x9a, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x50,
-	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x3c, 0x92, 0x41, 0x1f, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5,
-	0x85, 0xb3, 0x12, 0x0f, 0xe5, 0xbe, 0x85, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x88, 0x97,
-	0xe8, 0xa1, 0xa8, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x65,
-	0x78, 0x61, 0x6d, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01,
-	0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
-	0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x92, 0x41, 0x1c, 0x0a,
-	0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0c, 0xe5,
-	0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x94, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x22, 0x0a,
-	0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x12, 0xe8,
-	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe9, 0xa2, 0x98, 0xe7, 0x9b,
-	0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61,
-	0x6d, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x12,
+	0x12, 0xa7, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x25, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7,
+	0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x15, 0xe5, 0x8f, 0x91, 0xe9, 0x80, 0x81, 0xe7, 0x99, 0xbb,
+	0xe5, 0xbd, 0x95, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x0f, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x92, 0x41, 0x1f, 0x0a, 0x0c,
+	0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0f, 0xe9, 0xaa,
+	0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d,
+	0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x12, 0x94, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x1b, 0x0a, 0x0c, 0xe8,
+	0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0b, 0xe5, 0x88, 0xb7,
+	0xe6, 0x96, 0xb0, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
+	0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x7c, 0x0a, 0x06, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x92, 0x41,
+	0x1c, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12,
+	0x0c, 0xe9, 0x80, 0x80, 0xe5, 0x87, 0xba, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d,
+	0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x9a, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45,
+	0x78, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x92, 0x41, 0x1f, 0x0a, 0x0c, 0xe8, 0x80, 0x83,
+	0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0f, 0xe5, 0xbe, 0x85, 0xe8, 0x80,
+	0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x38, 0x92, 0x41, 0x1c, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b,
+	0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0c, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe8, 0x80, 0x83, 0xe8,
+	0xaf, 0x95, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x94, 0x01, 0x0a, 0x0c,
+	0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61,
+	0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3f, 0x92, 0x41, 0x22, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b,
+	0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x95, 0xe5,
+	0x8d, 0xb7, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
 	0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x12, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x34, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95,
-	0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x24, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0x80,
-	0x83, 0xe8, 0xaf, 0x95, 0xe4, 0xb8, 0x8a, 0xe6, 0xac, 0xa1, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae,
-	0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x65, 0x78, 0x61,
-	0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0xaf, 0x01, 0x0a, 0x10, 0x48, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61, 0x76, 0x65, 0x12, 0x24, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x25, 0x0a,
-	0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x15, 0xe5,
-	0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe5, 0xb9, 0xb6, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe7, 0xad,
-	0x94, 0xe6, 0xa1, 0x88, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
-	0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
-	0x74, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x92, 0x41, 0x16, 0x0a,
-	0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x06, 0xe6,
-	0x8f, 0x90, 0xe4, 0xba, 0xa4, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f,
-	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42,
-	0x87, 0x01, 0x92, 0x41, 0x70, 0x12, 0x16, 0x0a, 0x0f, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7,
-	0xab, 0xaf, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01,
-	0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x1d, 0x0a, 0x1b, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x0d, 0x08, 0x02, 0x1a, 0x07, 0x78, 0x2d, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x00, 0x5a, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x34, 0x0a,
+	0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x24, 0xe8,
+	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe4, 0xb8, 0x8a, 0xe6, 0xac,
+	0xa1, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe8, 0xae, 0xb0,
+	0xe5, 0xbd, 0x95, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x78, 0x61, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0xaf, 0x01, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64,
+	0x53, 0x61, 0x76, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64, 0x53,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4e, 0x92, 0x41, 0x25, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b,
+	0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x15, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe5, 0xb9, 0xb6, 0xe4,
+	0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x61, 0x76,
+	0x65, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x33, 0x92, 0x41, 0x16, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b,
+	0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x06, 0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0xaa, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x78, 0x74, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x92, 0x41,
+	0x31, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12,
+	0x21, 0xe8, 0x87, 0xaa, 0xe9, 0x80, 0x82, 0xe5, 0xba, 0x94, 0xe5, 0x87, 0xba, 0xe9, 0xa2, 0x98,
+	0xef, 0xbc, 0x9a, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9,
+	0xa2, 0x98, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0xb4, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41,
+	0x37, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12,
+	0x27, 0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xef, 0xbc, 0x8c,
+	0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe5,
+	0x86, 0x8d, 0xe8, 0xbf, 0x9b, 0xe5, 0x85, 0xa5, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa0, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41,
+	0x22, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12,
+	0x12, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe4, 0xba, 0x8b,
+	0xe4, 0xbb, 0xb6, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xb2, 0x01,
+	0x0a, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8,
+	0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x18, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xbe,
+	0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe8, 0xae, 0xb0, 0xe5,
+	0xbd, 0x95, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
+	0x61, 0x6d, 0x2f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x41, 0x92, 0x41, 0x25, 0x0a, 0x0c, 0xe8, 0xaf, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0x9b,
+	0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xbe, 0x85, 0xe8,
+	0xaf, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x92,
+	0x41, 0x28, 0x0a, 0x0c, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3,
+	0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0xe6, 0x8a, 0xa5, 0xe5, 0x91, 0x8a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x90, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x92, 0x41, 0x1f, 0x0a, 0x0c, 0xe8, 0xaf, 0x84, 0xe5, 0x88,
+	0x86, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0x12, 0x0f, 0xe9, 0x97, 0xae, 0xe7, 0xad, 0x94, 0xe9,
+	0xa2, 0x98, 0xe8, 0xaf, 0x84, 0xe5, 0x88, 0x86, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01,
+	0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x42, 0x87, 0x01, 0x92, 0x41, 0x70, 0x12, 0x16, 0x0a, 0x0f, 0xe8, 0x80,
+	0x83, 0xe8, 0xaf, 0x95, 0xe7, 0xab, 0xaf, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x32, 0x03, 0x31,
+	0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x1d, 0x0a, 0x1b, 0x0a, 0x0a, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0d, 0x08, 0x02, 0x1a, 0x07, 0x78,
+	0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x5a, 0x12, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_exam_api_v1_exam_proto_goTypes = []interface{}{
 	(*ExamLoginRequest)(nil),           // 0: exam_api.v1.ExamLoginRequest
-	(*GetExamPageListRequest)(nil),     // 1: exam_api.v1.GetExamPageListRequest
-	(*StartExamRequest)(nil),           // 2: exam_api.v1.StartExamRequest
-	(*ExamQuestionRequest)(nil),        // 3: exam_api.v1.ExamQuestionRequest
-	(*ExamQuestionRecordRequest)(nil),  // 4: exam_api.v1.ExamQuestionRecordRequest
-	(*HeartbeatAndSaveRequest)(nil),    // 5: exam_api.v1.HeartbeatAndSaveRequest
-	(*SubmitExamRequest)(nil),          // 6: exam_api.v1.SubmitExamRequest
-	(*ExamLoginResponse)(nil),          // 7: exam_api.v1.ExamLoginResponse
-	(*GetExamPageListResponse)(nil),    // 8: exam_api.v1.GetExamPageListResponse
-	(*StartExamResponse)(nil),          // 9: exam_api.v1.StartExamResponse
-	(*ExamQuestionResponse)(nil),       // 10: exam_api.v1.ExamQuestionResponse
-	(*ExamQuestionRecordResponse)(nil), // 11: exam_api.v1.ExamQuestionRecordResponse
-	(*HeartbeatAndSaveResponse)(nil),   // 12: exam_api.v1.HeartbeatAndSaveResponse
-	(*SubmitExamResponse)(nil),         // 13: exam_api.v1.SubmitExamResponse
+	(*RequestLoginCodeRequest)(nil),    // 1: exam_api.v1.RequestLoginCodeRequest
+	(*VerifyLoginCodeRequest)(nil),     // 2: exam_api.v1.VerifyLoginCodeRequest
+	(*RefreshTokenRequest)(nil),        // 3: exam_api.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),              // 4: exam_api.v1.LogoutRequest
+	(*GetExamPageListRequest)(nil),     // 5: exam_api.v1.GetExamPageListRequest
+	(*StartExamRequest)(nil),           // 6: exam_api.v1.StartExamRequest
+	(*ExamQuestionRequest)(nil),        // 7: exam_api.v1.ExamQuestionRequest
+	(*ExamQuestionRecordRequest)(nil),  // 8: exam_api.v1.ExamQuestionRecordRequest
+	(*HeartbeatAndSaveRequest)(nil),    // 9: exam_api.v1.HeartbeatAndSaveRequest
+	(*SubmitExamRequest)(nil),          // 10: exam_api.v1.SubmitExamRequest
+	(*NextQuestionRequest)(nil),        // 11: exam_api.v1.NextQuestionRequest
+	(*SubmitSectionRequest)(nil),       // 12: exam_api.v1.SubmitSectionRequest
+	(*ReportExamEventsRequest)(nil),    // 13: exam_api.v1.ReportExamEventsRequest
+	(*ExamAnswerTimelineRequest)(nil),  // 14: exam_api.v1.ExamAnswerTimelineRequest
+	(*GetGradingQueueRequest)(nil),     // 15: exam_api.v1.GetGradingQueueRequest
+	(*GetExamResultRequest)(nil),       // 16: exam_api.v1.GetExamResultRequest
+	(*GradeAnswerRequest)(nil),         // 17: exam_api.v1.GradeAnswerRequest
+	(*ExamLoginResponse)(nil),          // 18: exam_api.v1.ExamLoginResponse
+	(*RequestLoginCodeResponse)(nil),   // 19: exam_api.v1.RequestLoginCodeResponse
+	(*VerifyLoginCodeResponse)(nil),    // 20: exam_api.v1.VerifyLoginCodeResponse
+	(*RefreshTokenResponse)(nil),       // 21: exam_api.v1.RefreshTokenResponse
+	(*LogoutResponse)(nil),             // 22: exam_api.v1.LogoutResponse
+	(*GetExamPageListResponse)(nil),    // 23: exam_api.v1.GetExamPageListResponse
+	(*StartExamResponse)(nil),          // 24: exam_api.v1.StartExamResponse
+	(*ExamQuestionResponse)(nil),       // 25: exam_api.v1.ExamQuestionResponse
+	(*ExamQuestionRecordResponse)(nil), // 26: exam_api.v1.ExamQuestionRecordResponse
+	(*HeartbeatAndSaveResponse)(nil),   // 27: exam_api.v1.HeartbeatAndSaveResponse
+	(*SubmitExamResponse)(nil),         // 28: exam_api.v1.SubmitExamResponse
+	(*NextQuestionResponse)(nil),       // 29: exam_api.v1.NextQuestionResponse
+	(*SubmitSectionResponse)(nil),      // 30: exam_api.v1.SubmitSectionResponse
+	(*ReportExamEventsResponse)(nil),   // 31: exam_api.v1.ReportExamEventsResponse
+	(*ExamAnswerTimelineResponse)(nil), // 32: exam_api.v1.ExamAnswerTimelineResponse
+	(*GetGradingQueueResponse)(nil),    // 33: exam_api.v1.GetGradingQueueResponse
+	(*GetExamResultResponse)(nil),      // 34: exam_api.v1.GetExamResultResponse
+	(*GradeAnswerResponse)(nil),        // 35: exam_api.v1.GradeAnswerResponse
 }
 var file_exam_api_v1_exam_proto_depIdxs = []int32{
 	0,  // 0: exam_api.v1.ExamService.ExamLogin:input_type -> exam_api.v1.ExamLoginRequest
-	1,  // 1: exam_api.v1.ExamService.GetExamPageList:input_type -> exam_api.v1.GetExamPageListRequest
-	2,  // 2: exam_api.v1.ExamService.StartExam:input_type -> exam_api.v1.StartExamRequest
-	3,  // 3: exam_api.v1.ExamService.ExamQuestion:input_type -> exam_api.v1.ExamQuestionRequest
-	4,  // 4: exam_api.v1.ExamService.ExamQuestionRecord:input_type -> exam_api.v1.ExamQuestionRecordRequest
-	5,  // 5: exam_api.v1.ExamService.HeartbeatAndSave:input_type -> exam_api.v1.HeartbeatAndSaveRequest
-	6,  // 6: exam_api.v1.ExamService.SubmitExam:input_type -> exam_api.v1.SubmitExamRequest
-	7,  // 7: exam_api.v1.ExamService.ExamLogin:output_type -> exam_api.v1.ExamLoginResponse
-	8,  // 8: exam_api.v1.ExamService.GetExamPageList:output_type -> exam_api.v1.GetExamPageListResponse
-	9,  // 9: exam_api.v1.ExamService.StartExam:output_type -> exam_api.v1.StartExamResponse
-	10, // 10: exam_api.v1.ExamService.ExamQuestion:output_type -> exam_api.v1.ExamQuestionResponse
-	11, // 11: exam_api.v1.ExamService.ExamQuestionRecord:output_type -> exam_api.v1.ExamQuestionRecordResponse
-	12, // 12: exam_api.v1.ExamService.HeartbeatAndSave:output_type -> exam_api.v1.HeartbeatAndSaveResponse
-	13, // 13: exam_api.v1.ExamService.SubmitExam:output_type -> exam_api.v1.SubmitExamResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	1,  // 1: exam_api.v1.ExamService.RequestLoginCode:input_type -> exam_api.v1.RequestLoginCodeRequest
+	2,  // 2: exam_api.v1.ExamService.VerifyLoginCode:input_type -> exam_api.v1.VerifyLoginCodeRequest
+	3,  // 3: exam_api.v1.ExamService.RefreshToken:input_type -> exam_api.v1.RefreshTokenRequest
+	4,  // 4: exam_api.v1.ExamService.Logout:input_type -> exam_api.v1.LogoutRequest
+	5,  // 5: exam_api.v1.ExamService.GetExamPageList:input_type -> exam_api.v1.GetExamPageListRequest
+	6,  // 6: exam_api.v1.ExamService.StartExam:input_type -> exam_api.v1.StartExamRequest
+	7,  // 7: exam_api.v1.ExamService.ExamQuestion:input_type -> exam_api.v1.ExamQuestionRequest
+	8,  // 8: exam_api.v1.ExamService.ExamQuestionRecord:input_type -> exam_api.v1.ExamQuestionRecordRequest
+	9,  // 9: exam_api.v1.ExamService.HeartbeatAndSave:input_type -> exam_api.v1.HeartbeatAndSaveRequest
+	10, // 10: exam_api.v1.ExamService.SubmitExam:input_type -> exam_api.v1.SubmitExamRequest
+	11, // 11: exam_api.v1.ExamService.NextQuestion:input_type -> exam_api.v1.NextQuestionRequest
+	12, // 12: exam_api.v1.ExamService.SubmitSection:input_type -> exam_api.v1.SubmitSectionRequest
+	13, // 13: exam_api.v1.ExamService.ReportExamEvents:input_type -> exam_api.v1.ReportExamEventsRequest
+	14, // 14: exam_api.v1.ExamService.ExamAnswerTimeline:input_type -> exam_api.v1.ExamAnswerTimelineRequest
+	15, // 15: exam_api.v1.ExamService.GetGradingQueue:input_type -> exam_api.v1.GetGradingQueueRequest
+	16, // 16: exam_api.v1.ExamService.GetExamResult:input_type -> exam_api.v1.GetExamResultRequest
+	17, // 17: exam_api.v1.ExamService.GradeAnswer:input_type -> exam_api.v1.GradeAnswerRequest
+	18, // 18: exam_api.v1.ExamService.ExamLogin:output_type -> exam_api.v1.ExamLoginResponse
+	19, // 19: exam_api.v1.ExamService.RequestLoginCode:output_type -> exam_api.v1.RequestLoginCodeResponse
+	20, // 20: exam_api.v1.ExamService.VerifyLoginCode:output_type -> exam_api.v1.VerifyLoginCodeResponse
+	21, // 21: exam_api.v1.ExamService.RefreshToken:output_type -> exam_api.v1.RefreshTokenResponse
+	22, // 22: exam_api.v1.ExamService.Logout:output_type -> exam_api.v1.LogoutResponse
+	23, // 23: exam_api.v1.ExamService.GetExamPageList:output_type -> exam_api.v1.GetExamPageListResponse
+	24, // 24: exam_api.v1.ExamService.StartExam:output_type -> exam_api.v1.StartExamResponse
+	25, // 25: exam_api.v1.ExamService.ExamQuestion:output_type -> exam_api.v1.ExamQuestionResponse
+	26, // 26: exam_api.v1.ExamService.ExamQuestionRecord:output_type -> exam_api.v1.ExamQuestionRecordResponse
+	27, // 27: exam_api.v1.ExamService.HeartbeatAndSave:output_type -> exam_api.v1.HeartbeatAndSaveResponse
+	28, // 28: exam_api.v1.ExamService.SubmitExam:output_type -> exam_api.v1.SubmitExamResponse
+	29, // 29: exam_api.v1.ExamService.NextQuestion:output_type -> exam_api.v1.NextQuestionResponse
+	30, // 30: exam_api.v1.ExamService.SubmitSection:output_type -> exam_api.v1.SubmitSectionResponse
+	31, // 31: exam_api.v1.ExamService.ReportExamEvents:output_type -> exam_api.v1.ReportExamEventsResponse
+	32, // 32: exam_api.v1.ExamService.ExamAnswerTimeline:output_type -> exam_api.v1.ExamAnswerTimelineResponse
+	33, // 33: exam_api.v1.ExamService.GetGradingQueue:output_type -> exam_api.v1.GetGradingQueueResponse
+	34, // 34: exam_api.v1.ExamService.GetExamResult:output_type -> exam_api.v1.GetExamResultResponse
+	35, // 35: exam_api.v1.ExamService.GradeAnswer:output_type -> exam_api.v1.GradeAnswerResponse
+	18, // [18:36] is the sub-list for method output_type
+	0,  // [0:18] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
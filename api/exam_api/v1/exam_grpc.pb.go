@@ -24,6 +24,14 @@ const _ = grpc.SupportPackageIsVersion7
 type ExamServiceClient interface {
 	// 考试端登录
 	ExamLogin(ctx context.Context, in *ExamLoginRequest, opts ...grpc.CallOption) (*ExamLoginResponse, error)
+	// 发送登录验证码
+	RequestLoginCode(ctx context.Context, in *RequestLoginCodeRequest, opts ...grpc.CallOption) (*RequestLoginCodeResponse, error)
+	// 验证码登录
+	VerifyLoginCode(ctx context.Context, in *VerifyLoginCodeRequest, opts ...grpc.CallOption) (*VerifyLoginCodeResponse, error)
+	// 刷新token
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// 退出登录
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	// 待考试列表
 	GetExamPageList(ctx context.Context, in *GetExamPageListRequest, opts ...grpc.CallOption) (*GetExamPageListResponse, error)
 	// 开始考试
@@ -36,6 +44,20 @@ type ExamServiceClient interface {
 	HeartbeatAndSave(ctx context.Context, in *HeartbeatAndSaveRequest, opts ...grpc.CallOption) (*HeartbeatAndSaveResponse, error)
 	// 提交考试
 	SubmitExam(ctx context.Context, in *SubmitExamRequest, opts ...grpc.CallOption) (*SubmitExamResponse, error)
+	// 自适应出题：提交当前题目的答案并获取下一题
+	NextQuestion(ctx context.Context, in *NextQuestionRequest, opts ...grpc.CallOption) (*NextQuestionResponse, error)
+	// 提交分部
+	SubmitSection(ctx context.Context, in *SubmitSectionRequest, opts ...grpc.CallOption) (*SubmitSectionResponse, error)
+	// 上报考试事件
+	ReportExamEvents(ctx context.Context, in *ReportExamEventsRequest, opts ...grpc.CallOption) (*ReportExamEventsResponse, error)
+	// 回放答案修改记录
+	ExamAnswerTimeline(ctx context.Context, in *ExamAnswerTimelineRequest, opts ...grpc.CallOption) (*ExamAnswerTimelineResponse, error)
+	// 获取待评分的问答题答案
+	GetGradingQueue(ctx context.Context, in *GetGradingQueueRequest, opts ...grpc.CallOption) (*GetGradingQueueResponse, error)
+	// 获取考试结果报告
+	GetExamResult(ctx context.Context, in *GetExamResultRequest, opts ...grpc.CallOption) (*GetExamResultResponse, error)
+	// 问答题人工评分
+	GradeAnswer(ctx context.Context, in *GradeAnswerRequest, opts ...grpc.CallOption) (*GradeAnswerResponse, error)
 }
 
 type examServiceClient struct {
@@ -55,6 +77,42 @@ func (c *examServiceClient) ExamLogin(ctx context.Context, in *ExamLoginRequest,
 	return out, nil
 }
 
+func (c *examServiceClient) RequestLoginCode(ctx context.Context, in *RequestLoginCodeRequest, opts ...grpc.CallOption) (*RequestLoginCodeResponse, error) {
+	out := new(RequestLoginCodeResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/RequestLoginCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) VerifyLoginCode(ctx context.Context, in *VerifyLoginCodeRequest, opts ...grpc.CallOption) (*VerifyLoginCodeResponse, error) {
+	out := new(VerifyLoginCodeResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/VerifyLoginCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *examServiceClient) GetExamPageList(ctx context.Context, in *GetExamPageListRequest, opts ...grpc.CallOption) (*GetExamPageListResponse, error) {
 	out := new(GetExamPageListResponse)
 	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/GetExamPageList", in, out, opts...)
@@ -109,12 +167,83 @@ func (c *examServiceClient) SubmitExam(ctx context.Context, in *SubmitExamReques
 	return out, nil
 }
 
+func (c *examServiceClient) NextQuestion(ctx context.Context, in *NextQuestionRequest, opts ...grpc.CallOption) (*NextQuestionResponse, error) {
+	out := new(NextQuestionResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/NextQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) SubmitSection(ctx context.Context, in *SubmitSectionRequest, opts ...grpc.CallOption) (*SubmitSectionResponse, error) {
+	out := new(SubmitSectionResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/SubmitSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ReportExamEvents(ctx context.Context, in *ReportExamEventsRequest, opts ...grpc.CallOption) (*ReportExamEventsResponse, error) {
+	out := new(ReportExamEventsResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/ReportExamEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ExamAnswerTimeline(ctx context.Context, in *ExamAnswerTimelineRequest, opts ...grpc.CallOption) (*ExamAnswerTimelineResponse, error) {
+	out := new(ExamAnswerTimelineResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/ExamAnswerTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GetGradingQueue(ctx context.Context, in *GetGradingQueueRequest, opts ...grpc.CallOption) (*GetGradingQueueResponse, error) {
+	out := new(GetGradingQueueResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/GetGradingQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GetExamResult(ctx context.Context, in *GetExamResultRequest, opts ...grpc.CallOption) (*GetExamResultResponse, error) {
+	out := new(GetExamResultResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/GetExamResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GradeAnswer(ctx context.Context, in *GradeAnswerRequest, opts ...grpc.CallOption) (*GradeAnswerResponse, error) {
+	out := new(GradeAnswerResponse)
+	err := c.cc.Invoke(ctx, "/exam_api.v1.ExamService/GradeAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExamServiceServer is the server API for ExamService service.
 // All implementations must embed UnimplementedExamServiceServer
 // for forward compatibility
 type ExamServiceServer interface {
 	// 考试端登录
 	ExamLogin(context.Context, *ExamLoginRequest) (*ExamLoginResponse, error)
+	// 发送登录验证码
+	RequestLoginCode(context.Context, *RequestLoginCodeRequest) (*RequestLoginCodeResponse, error)
+	// 验证码登录
+	VerifyLoginCode(context.Context, *VerifyLoginCodeRequest) (*VerifyLoginCodeResponse, error)
+	// 刷新token
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// 退出登录
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 	// 待考试列表
 	GetExamPageList(context.Context, *GetExamPageListRequest) (*GetExamPageListResponse, error)
 	// 开始考试
@@ -127,6 +256,20 @@ type ExamServiceServer interface {
 	HeartbeatAndSave(context.Context, *HeartbeatAndSaveRequest) (*HeartbeatAndSaveResponse, error)
 	// 提交考试
 	SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error)
+	// 自适应出题：提交当前题目的答案并获取下一题
+	NextQuestion(context.Context, *NextQuestionRequest) (*NextQuestionResponse, error)
+	// 提交分部
+	SubmitSection(context.Context, *SubmitSectionRequest) (*SubmitSectionResponse, error)
+	// 上报考试事件
+	ReportExamEvents(context.Context, *ReportExamEventsRequest) (*ReportExamEventsResponse, error)
+	// 回放答案修改记录
+	ExamAnswerTimeline(context.Context, *ExamAnswerTimelineRequest) (*ExamAnswerTimelineResponse, error)
+	// 获取待评分的问答题答案
+	GetGradingQueue(context.Context, *GetGradingQueueRequest) (*GetGradingQueueResponse, error)
+	// 获取考试结果报告
+	GetExamResult(context.Context, *GetExamResultRequest) (*GetExamResultResponse, error)
+	// 问答题人工评分
+	GradeAnswer(context.Context, *GradeAnswerRequest) (*GradeAnswerResponse, error)
 	mustEmbedUnimplementedExamServiceServer()
 }
 
@@ -137,6 +280,18 @@ type UnimplementedExamServiceServer struct {
 func (UnimplementedExamServiceServer) ExamLogin(context.Context, *ExamLoginRequest) (*ExamLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExamLogin not implemented")
 }
+func (UnimplementedExamServiceServer) RequestLoginCode(context.Context, *RequestLoginCodeRequest) (*RequestLoginCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLoginCode not implemented")
+}
+func (UnimplementedExamServiceServer) VerifyLoginCode(context.Context, *VerifyLoginCodeRequest) (*VerifyLoginCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLoginCode not implemented")
+}
+func (UnimplementedExamServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedExamServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedExamServiceServer) GetExamPageList(context.Context, *GetExamPageListRequest) (*GetExamPageListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExamPageList not implemented")
 }
@@ -155,6 +310,27 @@ func (UnimplementedExamServiceServer) HeartbeatAndSave(context.Context, *Heartbe
 func (UnimplementedExamServiceServer) SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitExam not implemented")
 }
+func (UnimplementedExamServiceServer) NextQuestion(context.Context, *NextQuestionRequest) (*NextQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) SubmitSection(context.Context, *SubmitSectionRequest) (*SubmitSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSection not implemented")
+}
+func (UnimplementedExamServiceServer) ReportExamEvents(context.Context, *ReportExamEventsRequest) (*ReportExamEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExamEvents not implemented")
+}
+func (UnimplementedExamServiceServer) ExamAnswerTimeline(context.Context, *ExamAnswerTimelineRequest) (*ExamAnswerTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExamAnswerTimeline not implemented")
+}
+func (UnimplementedExamServiceServer) GetGradingQueue(context.Context, *GetGradingQueueRequest) (*GetGradingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingQueue not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamResult(context.Context, *GetExamResultRequest) (*GetExamResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExamResult not implemented")
+}
+func (UnimplementedExamServiceServer) GradeAnswer(context.Context, *GradeAnswerRequest) (*GradeAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeAnswer not implemented")
+}
 func (UnimplementedExamServiceServer) mustEmbedUnimplementedExamServiceServer() {}
 
 // UnsafeExamServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -186,6 +362,78 @@ func _ExamService_ExamLogin_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExamService_RequestLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).RequestLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/RequestLoginCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).RequestLoginCode(ctx, req.(*RequestLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_VerifyLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).VerifyLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/VerifyLoginCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).VerifyLoginCode(ctx, req.(*VerifyLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExamService_GetExamPageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetExamPageListRequest)
 	if err := dec(in); err != nil {
@@ -294,6 +542,132 @@ func _ExamService_SubmitExam_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExamService_NextQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).NextQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/NextQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).NextQuestion(ctx, req.(*NextQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_SubmitSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).SubmitSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/SubmitSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).SubmitSection(ctx, req.(*SubmitSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ReportExamEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportExamEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ReportExamEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/ReportExamEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ReportExamEvents(ctx, req.(*ReportExamEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ExamAnswerTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExamAnswerTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ExamAnswerTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/ExamAnswerTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ExamAnswerTimeline(ctx, req.(*ExamAnswerTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetGradingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetGradingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/GetGradingQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetGradingQueue(ctx, req.(*GetGradingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/GetExamResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamResult(ctx, req.(*GetExamResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GradeAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GradeAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exam_api.v1.ExamService/GradeAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GradeAnswer(ctx, req.(*GradeAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExamService_ServiceDesc is the grpc.ServiceDesc for ExamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +679,22 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExamLogin",
 			Handler:    _ExamService_ExamLogin_Handler,
 		},
+		{
+			MethodName: "RequestLoginCode",
+			Handler:    _ExamService_RequestLoginCode_Handler,
+		},
+		{
+			MethodName: "VerifyLoginCode",
+			Handler:    _ExamService_VerifyLoginCode_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _ExamService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _ExamService_Logout_Handler,
+		},
 		{
 			MethodName: "GetExamPageList",
 			Handler:    _ExamService_GetExamPageList_Handler,
@@ -329,6 +719,34 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitExam",
 			Handler:    _ExamService_SubmitExam_Handler,
 		},
+		{
+			MethodName: "NextQuestion",
+			Handler:    _ExamService_NextQuestion_Handler,
+		},
+		{
+			MethodName: "SubmitSection",
+			Handler:    _ExamService_SubmitSection_Handler,
+		},
+		{
+			MethodName: "ReportExamEvents",
+			Handler:    _ExamService_ReportExamEvents_Handler,
+		},
+		{
+			MethodName: "ExamAnswerTimeline",
+			Handler:    _ExamService_ExamAnswerTimeline_Handler,
+		},
+		{
+			MethodName: "GetGradingQueue",
+			Handler:    _ExamService_GetGradingQueue_Handler,
+		},
+		{
+			MethodName: "GetExamResult",
+			Handler:    _ExamService_GetExamResult_Handler,
+		},
+		{
+			MethodName: "GradeAnswer",
+			Handler:    _ExamService_GradeAnswer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exam_api/v1/exam.proto",
@@ -19,15 +19,28 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationExamServiceExamAnswerTimeline = "/exam_api.v1.ExamService/ExamAnswerTimeline"
 const OperationExamServiceExamLogin = "/exam_api.v1.ExamService/ExamLogin"
 const OperationExamServiceExamQuestion = "/exam_api.v1.ExamService/ExamQuestion"
 const OperationExamServiceExamQuestionRecord = "/exam_api.v1.ExamService/ExamQuestionRecord"
 const OperationExamServiceGetExamPageList = "/exam_api.v1.ExamService/GetExamPageList"
+const OperationExamServiceGetExamResult = "/exam_api.v1.ExamService/GetExamResult"
+const OperationExamServiceGetGradingQueue = "/exam_api.v1.ExamService/GetGradingQueue"
+const OperationExamServiceGradeAnswer = "/exam_api.v1.ExamService/GradeAnswer"
 const OperationExamServiceHeartbeatAndSave = "/exam_api.v1.ExamService/HeartbeatAndSave"
+const OperationExamServiceLogout = "/exam_api.v1.ExamService/Logout"
+const OperationExamServiceNextQuestion = "/exam_api.v1.ExamService/NextQuestion"
+const OperationExamServiceRefreshToken = "/exam_api.v1.ExamService/RefreshToken"
+const OperationExamServiceReportExamEvents = "/exam_api.v1.ExamService/ReportExamEvents"
+const OperationExamServiceRequestLoginCode = "/exam_api.v1.ExamService/RequestLoginCode"
 const OperationExamServiceStartExam = "/exam_api.v1.ExamService/StartExam"
 const OperationExamServiceSubmitExam = "/exam_api.v1.ExamService/SubmitExam"
+const OperationExamServiceSubmitSection = "/exam_api.v1.ExamService/SubmitSection"
+const OperationExamServiceVerifyLoginCode = "/exam_api.v1.ExamService/VerifyLoginCode"
 
 type ExamServiceHTTPServer interface {
+	// ExamAnswerTimeline回放答案修改记录
+	ExamAnswerTimeline(context.Context, *ExamAnswerTimelineRequest) (*ExamAnswerTimelineResponse, error)
 	// ExamLogin 考试端登录
 	ExamLogin(context.Context, *ExamLoginRequest) (*ExamLoginResponse, error)
 	// ExamQuestion 获取考试题目
@@ -36,23 +49,54 @@ type ExamServiceHTTPServer interface {
 	ExamQuestionRecord(context.Context, *ExamQuestionRecordRequest) (*ExamQuestionRecordResponse, error)
 	// GetExamPageList 待考试列表
 	GetExamPageList(context.Context, *GetExamPageListRequest) (*GetExamPageListResponse, error)
+	// GetExamResult获取考试结果报告
+	GetExamResult(context.Context, *GetExamResultRequest) (*GetExamResultResponse, error)
+	// GetGradingQueue获取待评分的问答题答案
+	GetGradingQueue(context.Context, *GetGradingQueueRequest) (*GetGradingQueueResponse, error)
+	// GradeAnswer问答题人工评分
+	GradeAnswer(context.Context, *GradeAnswerRequest) (*GradeAnswerResponse, error)
 	// HeartbeatAndSave心跳&保存答案
 	HeartbeatAndSave(context.Context, *HeartbeatAndSaveRequest) (*HeartbeatAndSaveResponse, error)
+	// Logout 退出登录
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// NextQuestion自适应出题：提交当前题目的答案并获取下一题
+	NextQuestion(context.Context, *NextQuestionRequest) (*NextQuestionResponse, error)
+	// RefreshToken 刷新token
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// ReportExamEvents上报考试事件
+	ReportExamEvents(context.Context, *ReportExamEventsRequest) (*ReportExamEventsResponse, error)
+	// RequestLoginCode 发送登录验证码
+	RequestLoginCode(context.Context, *RequestLoginCodeRequest) (*RequestLoginCodeResponse, error)
 	// StartExam 开始考试
 	StartExam(context.Context, *StartExamRequest) (*StartExamResponse, error)
 	// SubmitExam提交考试
 	SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error)
+	// SubmitSection提交分部
+	SubmitSection(context.Context, *SubmitSectionRequest) (*SubmitSectionResponse, error)
+	// VerifyLoginCode 验证码登录
+	VerifyLoginCode(context.Context, *VerifyLoginCodeRequest) (*VerifyLoginCodeResponse, error)
 }
 
 func RegisterExamServiceHTTPServer(s *http.Server, srv ExamServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/exam/login", _ExamService_ExamLogin0_HTTP_Handler(srv))
+	r.POST("/v1/exam/login_code", _ExamService_RequestLoginCode0_HTTP_Handler(srv))
+	r.POST("/v1/exam/login_code/verify", _ExamService_VerifyLoginCode0_HTTP_Handler(srv))
+	r.POST("/v1/exam/refresh_token", _ExamService_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/v1/exam/logout", _ExamService_Logout0_HTTP_Handler(srv))
 	r.GET("/v1/exam/page_list", _ExamService_GetExamPageList0_HTTP_Handler(srv))
 	r.POST("/v1/exam/start", _ExamService_StartExam0_HTTP_Handler(srv))
 	r.GET("/v1/exam/questions", _ExamService_ExamQuestion0_HTTP_Handler(srv))
 	r.GET("/v1/exam/exam_record", _ExamService_ExamQuestionRecord0_HTTP_Handler(srv))
 	r.POST("/v1/exam/heartbeat_and_save", _ExamService_HeartbeatAndSave0_HTTP_Handler(srv))
 	r.POST("/v1/exam/submit", _ExamService_SubmitExam0_HTTP_Handler(srv))
+	r.POST("/v1/exam/next_question", _ExamService_NextQuestion0_HTTP_Handler(srv))
+	r.POST("/v1/exam/submit_section", _ExamService_SubmitSection0_HTTP_Handler(srv))
+	r.POST("/v1/exam/events", _ExamService_ReportExamEvents0_HTTP_Handler(srv))
+	r.GET("/v1/exam/answer_timeline", _ExamService_ExamAnswerTimeline0_HTTP_Handler(srv))
+	r.GET("/v1/grading/queue", _ExamService_GetGradingQueue0_HTTP_Handler(srv))
+	r.GET("/v1/exam/result", _ExamService_GetExamResult0_HTTP_Handler(srv))
+	r.POST("/v1/grading/grade", _ExamService_GradeAnswer0_HTTP_Handler(srv))
 }
 
 func _ExamService_ExamLogin0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
@@ -77,6 +121,94 @@ func _ExamService_ExamLogin0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx ht
 	}
 }
 
+func _ExamService_RequestLoginCode0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestLoginCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceRequestLoginCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestLoginCode(ctx, req.(*RequestLoginCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestLoginCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_VerifyLoginCode0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyLoginCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceVerifyLoginCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyLoginCode(ctx, req.(*VerifyLoginCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyLoginCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_RefreshToken0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_Logout0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ExamService_GetExamPageList0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetExamPageListRequest
@@ -200,14 +332,170 @@ func _ExamService_SubmitExam0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx h
 	}
 }
 
+func _ExamService_NextQuestion0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NextQuestionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceNextQuestion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.NextQuestion(ctx, req.(*NextQuestionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NextQuestionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_SubmitSection0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitSectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceSubmitSection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitSection(ctx, req.(*SubmitSectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubmitSectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_ReportExamEvents0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportExamEventsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceReportExamEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportExamEvents(ctx, req.(*ReportExamEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportExamEventsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_ExamAnswerTimeline0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExamAnswerTimelineRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceExamAnswerTimeline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExamAnswerTimeline(ctx, req.(*ExamAnswerTimelineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExamAnswerTimelineResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_GetGradingQueue0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetGradingQueueRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceGetGradingQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetGradingQueue(ctx, req.(*GetGradingQueueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetGradingQueueResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_GetExamResult0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExamResultRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceGetExamResult)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetExamResult(ctx, req.(*GetExamResultRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetExamResultResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExamService_GradeAnswer0_HTTP_Handler(srv ExamServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GradeAnswerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExamServiceGradeAnswer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GradeAnswer(ctx, req.(*GradeAnswerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GradeAnswerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ExamServiceHTTPClient interface {
+	ExamAnswerTimeline(ctx context.Context, req *ExamAnswerTimelineRequest, opts ...http.CallOption) (rsp *ExamAnswerTimelineResponse, err error)
 	ExamLogin(ctx context.Context, req *ExamLoginRequest, opts ...http.CallOption) (rsp *ExamLoginResponse, err error)
 	ExamQuestion(ctx context.Context, req *ExamQuestionRequest, opts ...http.CallOption) (rsp *ExamQuestionResponse, err error)
 	ExamQuestionRecord(ctx context.Context, req *ExamQuestionRecordRequest, opts ...http.CallOption) (rsp *ExamQuestionRecordResponse, err error)
 	GetExamPageList(ctx context.Context, req *GetExamPageListRequest, opts ...http.CallOption) (rsp *GetExamPageListResponse, err error)
+	GetExamResult(ctx context.Context, req *GetExamResultRequest, opts ...http.CallOption) (rsp *GetExamResultResponse, err error)
+	GetGradingQueue(ctx context.Context, req *GetGradingQueueRequest, opts ...http.CallOption) (rsp *GetGradingQueueResponse, err error)
+	GradeAnswer(ctx context.Context, req *GradeAnswerRequest, opts ...http.CallOption) (rsp *GradeAnswerResponse, err error)
 	HeartbeatAndSave(ctx context.Context, req *HeartbeatAndSaveRequest, opts ...http.CallOption) (rsp *HeartbeatAndSaveResponse, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutResponse, err error)
+	NextQuestion(ctx context.Context, req *NextQuestionRequest, opts ...http.CallOption) (rsp *NextQuestionResponse, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenResponse, err error)
+	ReportExamEvents(ctx context.Context, req *ReportExamEventsRequest, opts ...http.CallOption) (rsp *ReportExamEventsResponse, err error)
+	RequestLoginCode(ctx context.Context, req *RequestLoginCodeRequest, opts ...http.CallOption) (rsp *RequestLoginCodeResponse, err error)
 	StartExam(ctx context.Context, req *StartExamRequest, opts ...http.CallOption) (rsp *StartExamResponse, err error)
 	SubmitExam(ctx context.Context, req *SubmitExamRequest, opts ...http.CallOption) (rsp *SubmitExamResponse, err error)
+	SubmitSection(ctx context.Context, req *SubmitSectionRequest, opts ...http.CallOption) (rsp *SubmitSectionResponse, err error)
+	VerifyLoginCode(ctx context.Context, req *VerifyLoginCodeRequest, opts ...http.CallOption) (rsp *VerifyLoginCodeResponse, err error)
 }
 
 type ExamServiceHTTPClientImpl struct {
@@ -218,6 +506,19 @@ func NewExamServiceHTTPClient(client *http.Client) ExamServiceHTTPClient {
 	return &ExamServiceHTTPClientImpl{client}
 }
 
+func (c *ExamServiceHTTPClientImpl) ExamAnswerTimeline(ctx context.Context, in *ExamAnswerTimelineRequest, opts ...http.CallOption) (*ExamAnswerTimelineResponse, error) {
+	var out ExamAnswerTimelineResponse
+	pattern := "/v1/exam/answer_timeline"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExamServiceExamAnswerTimeline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ExamServiceHTTPClientImpl) ExamLogin(ctx context.Context, in *ExamLoginRequest, opts ...http.CallOption) (*ExamLoginResponse, error) {
 	var out ExamLoginResponse
 	pattern := "/v1/exam/login"
@@ -270,6 +571,45 @@ func (c *ExamServiceHTTPClientImpl) GetExamPageList(ctx context.Context, in *Get
 	return &out, nil
 }
 
+func (c *ExamServiceHTTPClientImpl) GetExamResult(ctx context.Context, in *GetExamResultRequest, opts ...http.CallOption) (*GetExamResultResponse, error) {
+	var out GetExamResultResponse
+	pattern := "/v1/exam/result"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExamServiceGetExamResult))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) GetGradingQueue(ctx context.Context, in *GetGradingQueueRequest, opts ...http.CallOption) (*GetGradingQueueResponse, error) {
+	var out GetGradingQueueResponse
+	pattern := "/v1/grading/queue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExamServiceGetGradingQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) GradeAnswer(ctx context.Context, in *GradeAnswerRequest, opts ...http.CallOption) (*GradeAnswerResponse, error) {
+	var out GradeAnswerResponse
+	pattern := "/v1/grading/grade"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceGradeAnswer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ExamServiceHTTPClientImpl) HeartbeatAndSave(ctx context.Context, in *HeartbeatAndSaveRequest, opts ...http.CallOption) (*HeartbeatAndSaveResponse, error) {
 	var out HeartbeatAndSaveResponse
 	pattern := "/v1/exam/heartbeat_and_save"
@@ -283,6 +623,71 @@ func (c *ExamServiceHTTPClientImpl) HeartbeatAndSave(ctx context.Context, in *He
 	return &out, nil
 }
 
+func (c *ExamServiceHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutResponse, error) {
+	var out LogoutResponse
+	pattern := "/v1/exam/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) NextQuestion(ctx context.Context, in *NextQuestionRequest, opts ...http.CallOption) (*NextQuestionResponse, error) {
+	var out NextQuestionResponse
+	pattern := "/v1/exam/next_question"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceNextQuestion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenResponse, error) {
+	var out RefreshTokenResponse
+	pattern := "/v1/exam/refresh_token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) ReportExamEvents(ctx context.Context, in *ReportExamEventsRequest, opts ...http.CallOption) (*ReportExamEventsResponse, error) {
+	var out ReportExamEventsResponse
+	pattern := "/v1/exam/events"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceReportExamEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) RequestLoginCode(ctx context.Context, in *RequestLoginCodeRequest, opts ...http.CallOption) (*RequestLoginCodeResponse, error) {
+	var out RequestLoginCodeResponse
+	pattern := "/v1/exam/login_code"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceRequestLoginCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ExamServiceHTTPClientImpl) StartExam(ctx context.Context, in *StartExamRequest, opts ...http.CallOption) (*StartExamResponse, error) {
 	var out StartExamResponse
 	pattern := "/v1/exam/start"
@@ -308,3 +713,29 @@ func (c *ExamServiceHTTPClientImpl) SubmitExam(ctx context.Context, in *SubmitEx
 	}
 	return &out, nil
 }
+
+func (c *ExamServiceHTTPClientImpl) SubmitSection(ctx context.Context, in *SubmitSectionRequest, opts ...http.CallOption) (*SubmitSectionResponse, error) {
+	var out SubmitSectionResponse
+	pattern := "/v1/exam/submit_section"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceSubmitSection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExamServiceHTTPClientImpl) VerifyLoginCode(ctx context.Context, in *VerifyLoginCodeRequest, opts ...http.CallOption) (*VerifyLoginCodeResponse, error) {
+	var out VerifyLoginCodeResponse
+	pattern := "/v1/exam/login_code/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExamServiceVerifyLoginCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
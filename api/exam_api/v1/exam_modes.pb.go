@@ -125,6 +125,7 @@ const (
 	StageNumber_CalculatePoints StageNumber = 3 // 已算分
 	StageNumber_Expire          StageNumber = 4 // 已过期
 	StageNumber_Failed          StageNumber = 5 // 处理失败
+	StageNumber_AwaitingGrading StageNumber = 6 // 待人工评分（已提交，问答题评分完成后再算分）
 )
 
 // Enum value maps for StageNumber.
@@ -136,6 +137,7 @@ var (
 		3: "CalculatePoints",
 		4: "Expire",
 		5: "Failed",
+		6: "AwaitingGrading",
 	}
 	StageNumber_value = map[string]int32{
 		"NoStart":         0,
@@ -144,6 +146,7 @@ var (
 		"CalculatePoints": 3,
 		"Expire":          4,
 		"Failed":          5,
+		"AwaitingGrading": 6,
 	}
 )
 
@@ -180,6 +183,12 @@ const (
 	QuestionType_RadioChoice    QuestionType = 0
 	QuestionType_MultipleChoice QuestionType = 1
 	QuestionType_Judge          QuestionType = 2
+	QuestionType_Likert         QuestionType = 3 // 量表题：从量表刻度（选项）中选一个
+	QuestionType_Ranking        QuestionType = 4 // 排序题：对全部选项排序
+	QuestionType_ForcedChoice   QuestionType = 5 // 迫选题：分别选出最符合和最不符合的选项
+	QuestionType_FillIn         QuestionType = 6 // 填空题：按参考答案自动评分
+	QuestionType_Numeric        QuestionType = 7 // 数值题：与参考值的误差在允许范围内得分
+	QuestionType_Essay          QuestionType = 8 // 问答题：人工评分
 )
 
 // Enum value maps for QuestionType.
@@ -188,11 +197,23 @@ var (
 		0: "RadioChoice",
 		1: "MultipleChoice",
 		2: "Judge",
+		3: "Likert",
+		4: "Ranking",
+		5: "ForcedChoice",
+		6: "FillIn",
+		7: "Numeric",
+		8: "Essay",
 	}
 	QuestionType_value = map[string]int32{
 		"RadioChoice":    0,
 		"MultipleChoice": 1,
 		"Judge":          2,
+		"Likert":         3,
+		"Ranking":        4,
+		"ForcedChoice":   5,
+		"FillIn":         6,
+		"Numeric":        7,
+		"Essay":          8,
 	}
 )
 
@@ -223,6 +244,159 @@ func (QuestionType) EnumDescriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{3}
 }
 
+type MediaType int32
+
+const (
+	MediaType_MediaUnknown MediaType = 0
+	MediaType_Image        MediaType = 1 // 图片
+	MediaType_Audio        MediaType = 2 // 音频
+	MediaType_Video        MediaType = 3 // 视频
+)
+
+// Enum value maps for MediaType.
+var (
+	MediaType_name = map[int32]string{
+		0: "MediaUnknown",
+		1: "Image",
+		2: "Audio",
+		3: "Video",
+	}
+	MediaType_value = map[string]int32{
+		"MediaUnknown": 0,
+		"Image":        1,
+		"Audio":        2,
+		"Video":        3,
+	}
+)
+
+func (x MediaType) Enum() *MediaType {
+	p := new(MediaType)
+	*p = x
+	return p
+}
+
+func (x MediaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_exam_api_v1_exam_modes_proto_enumTypes[4].Descriptor()
+}
+
+func (MediaType) Type() protoreflect.EnumType {
+	return &file_exam_api_v1_exam_modes_proto_enumTypes[4]
+}
+
+func (x MediaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaType.Descriptor instead.
+func (MediaType) EnumDescriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{4}
+}
+
+type SectionStatus int32
+
+const (
+	SectionStatus_SectionNotStarted SectionStatus = 0 // 未开始
+	SectionStatus_SectionInProgress SectionStatus = 1 // 作答中
+	SectionStatus_SectionSubmitted  SectionStatus = 2 // 已提交
+	SectionStatus_SectionTimeout    SectionStatus = 3 // 已超时
+)
+
+// Enum value maps for SectionStatus.
+var (
+	SectionStatus_name = map[int32]string{
+		0: "SectionNotStarted",
+		1: "SectionInProgress",
+		2: "SectionSubmitted",
+		3: "SectionTimeout",
+	}
+	SectionStatus_value = map[string]int32{
+		"SectionNotStarted": 0,
+		"SectionInProgress": 1,
+		"SectionSubmitted":  2,
+		"SectionTimeout":    3,
+	}
+)
+
+func (x SectionStatus) Enum() *SectionStatus {
+	p := new(SectionStatus)
+	*p = x
+	return p
+}
+
+func (x SectionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SectionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_exam_api_v1_exam_modes_proto_enumTypes[5].Descriptor()
+}
+
+func (SectionStatus) Type() protoreflect.EnumType {
+	return &file_exam_api_v1_exam_modes_proto_enumTypes[5]
+}
+
+func (x SectionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SectionStatus.Descriptor instead.
+func (SectionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{5}
+}
+
+type ResultVisibility int32
+
+const (
+	ResultVisibility_ResultHidden    ResultVisibility = 0 // 不可见
+	ResultVisibility_ResultScoreOnly ResultVisibility = 1 // 仅分数
+	ResultVisibility_ResultFull      ResultVisibility = 2 // 完整报告（含评语）
+)
+
+// Enum value maps for ResultVisibility.
+var (
+	ResultVisibility_name = map[int32]string{
+		0: "ResultHidden",
+		1: "ResultScoreOnly",
+		2: "ResultFull",
+	}
+	ResultVisibility_value = map[string]int32{
+		"ResultHidden":    0,
+		"ResultScoreOnly": 1,
+		"ResultFull":      2,
+	}
+)
+
+func (x ResultVisibility) Enum() *ResultVisibility {
+	p := new(ResultVisibility)
+	*p = x
+	return p
+}
+
+func (x ResultVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_exam_api_v1_exam_modes_proto_enumTypes[6].Descriptor()
+}
+
+func (ResultVisibility) Type() protoreflect.EnumType {
+	return &file_exam_api_v1_exam_modes_proto_enumTypes[6]
+}
+
+func (x ResultVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultVisibility.Descriptor instead.
+func (ResultVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{6}
+}
+
 type ExamLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -283,8 +457,9 @@ type ExamLoginResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserName string `protobuf:"bytes,1,opt,name=user_name,json=user_name,proto3" json:"user_name"`
-	Token    string `protobuf:"bytes,2,opt,name=token,json=token=,proto3" json:"token"`
+	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=user_name,proto3" json:"user_name"`
+	Token        string `protobuf:"bytes,2,opt,name=token,json=token=,proto3" json:"token"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refresh_token,json=refresh_token,proto3" json:"refresh_token"`
 }
 
 func (x *ExamLoginResponse) Reset() {
@@ -333,17 +508,23 @@ func (x *ExamLoginResponse) GetToken() string {
 	return ""
 }
 
-type GetExamPageListRequest struct {
+func (x *ExamLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RequestLoginCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageIndex int32 `protobuf:"varint,1,opt,name=page_index,json=page_index,proto3" json:"page_index"`
-	PageSize  int32 `protobuf:"varint,2,opt,name=page_size,json=page_size,proto3" json:"page_size"`
+	LoginAccount string `protobuf:"bytes,1,opt,name=login_account,json=login_account,proto3" json:"login_account"`
 }
 
-func (x *GetExamPageListRequest) Reset() {
-	*x = GetExamPageListRequest{}
+func (x *RequestLoginCodeRequest) Reset() {
+	*x = RequestLoginCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -351,13 +532,13 @@ func (x *GetExamPageListRequest) Reset() {
 	}
 }
 
-func (x *GetExamPageListRequest) String() string {
+func (x *RequestLoginCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExamPageListRequest) ProtoMessage() {}
+func (*RequestLoginCodeRequest) ProtoMessage() {}
 
-func (x *GetExamPageListRequest) ProtoReflect() protoreflect.Message {
+func (x *RequestLoginCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,36 +550,26 @@ func (x *GetExamPageListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExamPageListRequest.ProtoReflect.Descriptor instead.
-func (*GetExamPageListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*RequestLoginCodeRequest) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetExamPageListRequest) GetPageIndex() int32 {
-	if x != nil {
-		return x.PageIndex
-	}
-	return 0
-}
-
-func (x *GetExamPageListRequest) GetPageSize() int32 {
+func (x *RequestLoginCodeRequest) GetLoginAccount() string {
 	if x != nil {
-		return x.PageSize
+		return x.LoginAccount
 	}
-	return 0
+	return ""
 }
 
-type GetExamPageListResponse struct {
+type RequestLoginCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ExamList []*ExamData `protobuf:"bytes,1,rep,name=exam_list,json=exam_list,proto3" json:"exam_list"`
-	Total    int64       `protobuf:"varint,2,opt,name=total,json=total,proto3" json:"total"`
 }
 
-func (x *GetExamPageListResponse) Reset() {
-	*x = GetExamPageListResponse{}
+func (x *RequestLoginCodeResponse) Reset() {
+	*x = RequestLoginCodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,13 +577,13 @@ func (x *GetExamPageListResponse) Reset() {
 	}
 }
 
-func (x *GetExamPageListResponse) String() string {
+func (x *RequestLoginCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExamPageListResponse) ProtoMessage() {}
+func (*RequestLoginCodeResponse) ProtoMessage() {}
 
-func (x *GetExamPageListResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestLoginCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -424,37 +595,22 @@ func (x *GetExamPageListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExamPageListResponse.ProtoReflect.Descriptor instead.
-func (*GetExamPageListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLoginCodeResponse.ProtoReflect.Descriptor instead.
+func (*RequestLoginCodeResponse) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetExamPageListResponse) GetExamList() []*ExamData {
-	if x != nil {
-		return x.ExamList
-	}
-	return nil
-}
-
-func (x *GetExamPageListResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type ExamData struct {
+type VerifyLoginCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamineeAssociationId string `protobuf:"bytes,1,opt,name=examinee_association_id,json=examinee_association_id,proto3" json:"examinee_association_id"`
-	SalesPaperName        string `protobuf:"bytes,2,opt,name=sales_paper_name,json=sales_paper_name,proto3" json:"sales_paper_name"`
-	ExamStatus            int32  `protobuf:"varint,3,opt,name=exam_status,json=exam_status,proto3" json:"exam_status"`
+	LoginAccount string `protobuf:"bytes,1,opt,name=login_account,json=login_account,proto3" json:"login_account"`
+	Code         string `protobuf:"bytes,2,opt,name=code,json=code,proto3" json:"code"`
 }
 
-func (x *ExamData) Reset() {
-	*x = ExamData{}
+func (x *VerifyLoginCodeRequest) Reset() {
+	*x = VerifyLoginCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,13 +618,13 @@ func (x *ExamData) Reset() {
 	}
 }
 
-func (x *ExamData) String() string {
+func (x *VerifyLoginCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamData) ProtoMessage() {}
+func (*VerifyLoginCodeRequest) ProtoMessage() {}
 
-func (x *ExamData) ProtoReflect() protoreflect.Message {
+func (x *VerifyLoginCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,42 +636,37 @@ func (x *ExamData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamData.ProtoReflect.Descriptor instead.
-func (*ExamData) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLoginCodeRequest) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ExamData) GetExamineeAssociationId() string {
+func (x *VerifyLoginCodeRequest) GetLoginAccount() string {
 	if x != nil {
-		return x.ExamineeAssociationId
+		return x.LoginAccount
 	}
 	return ""
 }
 
-func (x *ExamData) GetSalesPaperName() string {
+func (x *VerifyLoginCodeRequest) GetCode() string {
 	if x != nil {
-		return x.SalesPaperName
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ExamData) GetExamStatus() int32 {
-	if x != nil {
-		return x.ExamStatus
-	}
-	return 0
-}
-
-type StartExamRequest struct {
+type VerifyLoginCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamineeAssociationId string `protobuf:"bytes,1,opt,name=examinee_association_id,json=examinee_association_id,proto3" json:"examinee_association_id"`
+	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=user_name,proto3" json:"user_name"`
+	Token        string `protobuf:"bytes,2,opt,name=token,json=token,proto3" json:"token"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refresh_token,json=refresh_token,proto3" json:"refresh_token"`
 }
 
-func (x *StartExamRequest) Reset() {
-	*x = StartExamRequest{}
+func (x *VerifyLoginCodeResponse) Reset() {
+	*x = VerifyLoginCodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,13 +674,13 @@ func (x *StartExamRequest) Reset() {
 	}
 }
 
-func (x *StartExamRequest) String() string {
+func (x *VerifyLoginCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartExamRequest) ProtoMessage() {}
+func (*VerifyLoginCodeResponse) ProtoMessage() {}
 
-func (x *StartExamRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyLoginCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,31 +692,42 @@ func (x *StartExamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartExamRequest.ProtoReflect.Descriptor instead.
-func (*StartExamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyLoginCodeResponse.ProtoReflect.Descriptor instead.
+func (*VerifyLoginCodeResponse) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StartExamRequest) GetExamineeAssociationId() string {
+func (x *VerifyLoginCodeResponse) GetUserName() string {
 	if x != nil {
-		return x.ExamineeAssociationId
+		return x.UserName
 	}
 	return ""
 }
 
-type StartExamResponse struct {
+func (x *VerifyLoginCodeResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyLoginCodeResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamToken     string `protobuf:"bytes,1,opt,name=exam_token,json=exam_token,proto3" json:"exam_token"`
-	TotalDuration int32  `protobuf:"varint,2,opt,name=total_duration,json=total_duration,proto3" json:"total_duration"`
-	UsedDuration  int32  `protobuf:"varint,3,opt,name=used_duration,json=used_duration,proto3" json:"used_duration"`
-	Remaining     int32  `protobuf:"varint,4,opt,name=remaining,json=remaining,proto3" json:"remaining"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refresh_token,proto3" json:"refresh_token"`
 }
 
-func (x *StartExamResponse) Reset() {
-	*x = StartExamResponse{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,13 +735,13 @@ func (x *StartExamResponse) Reset() {
 	}
 }
 
-func (x *StartExamResponse) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartExamResponse) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *StartExamResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,53 +753,29 @@ func (x *StartExamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartExamResponse.ProtoReflect.Descriptor instead.
-func (*StartExamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StartExamResponse) GetExamToken() string {
+func (x *RefreshTokenRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.ExamToken
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *StartExamResponse) GetTotalDuration() int32 {
-	if x != nil {
-		return x.TotalDuration
-	}
-	return 0
-}
-
-func (x *StartExamResponse) GetUsedDuration() int32 {
-	if x != nil {
-		return x.UsedDuration
-	}
-	return 0
-}
-
-func (x *StartExamResponse) GetRemaining() int32 {
-	if x != nil {
-		return x.Remaining
-	}
-	return 0
-}
-
-type QuestionData struct {
+type RefreshTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId          string                `protobuf:"bytes,1,opt,name=question_id,json=question_id,proto3" json:"question_id"`
-	Title               string                `protobuf:"bytes,2,opt,name=title,json=title,proto3" json:"title"`
-	QuestionTypeId      QuestionType          `protobuf:"varint,3,opt,name=question_type_id,json=question_type_id,proto3,enum=exam_api.v1.QuestionType" json:"question_type_id"`
-	Order               int32                 `protobuf:"varint,4,opt,name=order,json=order,proto3" json:"order"`
-	QuestionOptionsData []*QuestionOptionData `protobuf:"bytes,5,rep,name=question_options_data,json=question_options_data,proto3" json:"question_options_data"`
+	Token        string `protobuf:"bytes,1,opt,name=token,json=token,proto3" json:"token"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refresh_token,proto3" json:"refresh_token"`
 }
 
-func (x *QuestionData) Reset() {
-	*x = QuestionData{}
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +783,13 @@ func (x *QuestionData) Reset() {
 	}
 }
 
-func (x *QuestionData) String() string {
+func (x *RefreshTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionData) ProtoMessage() {}
+func (*RefreshTokenResponse) ProtoMessage() {}
 
-func (x *QuestionData) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,58 +801,35 @@ func (x *QuestionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionData.ProtoReflect.Descriptor instead.
-func (*QuestionData) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *QuestionData) GetQuestionId() string {
+func (x *RefreshTokenResponse) GetToken() string {
 	if x != nil {
-		return x.QuestionId
+		return x.Token
 	}
 	return ""
 }
 
-func (x *QuestionData) GetTitle() string {
+func (x *RefreshTokenResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.Title
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *QuestionData) GetQuestionTypeId() QuestionType {
-	if x != nil {
-		return x.QuestionTypeId
-	}
-	return QuestionType_RadioChoice
-}
-
-func (x *QuestionData) GetOrder() int32 {
-	if x != nil {
-		return x.Order
-	}
-	return 0
-}
-
-func (x *QuestionData) GetQuestionOptionsData() []*QuestionOptionData {
-	if x != nil {
-		return x.QuestionOptionsData
-	}
-	return nil
-}
-
-type QuestionOptionData struct {
+type LogoutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionOptionId string `protobuf:"bytes,1,opt,name=question_option_id,json=question_option_id,proto3" json:"question_option_id"`
-	Description      string `protobuf:"bytes,2,opt,name=description,json=description,proto3" json:"description"`
-	SerialNumber     string `protobuf:"bytes,3,opt,name=serial_number,json=serial_number,proto3" json:"serial_number"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refresh_token,proto3" json:"refresh_token"`
 }
 
-func (x *QuestionOptionData) Reset() {
-	*x = QuestionOptionData{}
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,13 +837,13 @@ func (x *QuestionOptionData) Reset() {
 	}
 }
 
-func (x *QuestionOptionData) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionOptionData) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *QuestionOptionData) ProtoReflect() protoreflect.Message {
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,40 +855,26 @@ func (x *QuestionOptionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionOptionData.ProtoReflect.Descriptor instead.
-func (*QuestionOptionData) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QuestionOptionData) GetQuestionOptionId() string {
-	if x != nil {
-		return x.QuestionOptionId
-	}
-	return ""
-}
-
-func (x *QuestionOptionData) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *QuestionOptionData) GetSerialNumber() string {
+func (x *LogoutRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.SerialNumber
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type ExamQuestionRequest struct {
+type LogoutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ExamQuestionRequest) Reset() {
-	*x = ExamQuestionRequest{}
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -781,13 +882,13 @@ func (x *ExamQuestionRequest) Reset() {
 	}
 }
 
-func (x *ExamQuestionRequest) String() string {
+func (x *LogoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamQuestionRequest) ProtoMessage() {}
+func (*LogoutResponse) ProtoMessage() {}
 
-func (x *ExamQuestionRequest) ProtoReflect() protoreflect.Message {
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -799,21 +900,22 @@ func (x *ExamQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamQuestionRequest.ProtoReflect.Descriptor instead.
-func (*ExamQuestionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{9}
 }
 
-type ExamQuestionResponse struct {
+type GetExamPageListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionData []*QuestionData `protobuf:"bytes,6,rep,name=question_data,json=question_data,proto3" json:"question_data"`
+	PageIndex int32 `protobuf:"varint,1,opt,name=page_index,json=page_index,proto3" json:"page_index"`
+	PageSize  int32 `protobuf:"varint,2,opt,name=page_size,json=page_size,proto3" json:"page_size"`
 }
 
-func (x *ExamQuestionResponse) Reset() {
-	*x = ExamQuestionResponse{}
+func (x *GetExamPageListRequest) Reset() {
+	*x = GetExamPageListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,13 +923,13 @@ func (x *ExamQuestionResponse) Reset() {
 	}
 }
 
-func (x *ExamQuestionResponse) String() string {
+func (x *GetExamPageListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamQuestionResponse) ProtoMessage() {}
+func (*GetExamPageListRequest) ProtoMessage() {}
 
-func (x *ExamQuestionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetExamPageListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,26 +941,36 @@ func (x *ExamQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamQuestionResponse.ProtoReflect.Descriptor instead.
-func (*ExamQuestionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExamPageListRequest.ProtoReflect.Descriptor instead.
+func (*GetExamPageListRequest) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ExamQuestionResponse) GetQuestionData() []*QuestionData {
+func (x *GetExamPageListRequest) GetPageIndex() int32 {
 	if x != nil {
-		return x.QuestionData
+		return x.PageIndex
 	}
-	return nil
+	return 0
 }
 
-type ExamQuestionRecordRequest struct {
+func (x *GetExamPageListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetExamPageListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ExamList []*ExamData `protobuf:"bytes,1,rep,name=exam_list,json=exam_list,proto3" json:"exam_list"`
+	Total    int64       `protobuf:"varint,2,opt,name=total,json=total,proto3" json:"total"`
 }
 
-func (x *ExamQuestionRecordRequest) Reset() {
-	*x = ExamQuestionRecordRequest{}
+func (x *GetExamPageListResponse) Reset() {
+	*x = GetExamPageListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,13 +978,13 @@ func (x *ExamQuestionRecordRequest) Reset() {
 	}
 }
 
-func (x *ExamQuestionRecordRequest) String() string {
+func (x *GetExamPageListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamQuestionRecordRequest) ProtoMessage() {}
+func (*GetExamPageListResponse) ProtoMessage() {}
 
-func (x *ExamQuestionRecordRequest) ProtoReflect() protoreflect.Message {
+func (x *GetExamPageListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,21 +996,41 @@ func (x *ExamQuestionRecordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamQuestionRecordRequest.ProtoReflect.Descriptor instead.
-func (*ExamQuestionRecordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExamPageListResponse.ProtoReflect.Descriptor instead.
+func (*GetExamPageListResponse) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{11}
 }
 
-type ExamQuestionRecordResponse struct {
+func (x *GetExamPageListResponse) GetExamList() []*ExamData {
+	if x != nil {
+		return x.ExamList
+	}
+	return nil
+}
+
+func (x *GetExamPageListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ExamData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnswerData []*QuestionAnswerData `protobuf:"bytes,6,rep,name=answer_data,json=answer_data,proto3" json:"answer_data"`
+	ExamineeAssociationId string `protobuf:"bytes,1,opt,name=examinee_association_id,json=examinee_association_id,proto3" json:"examinee_association_id"`
+	SalesPaperName        string `protobuf:"bytes,2,opt,name=sales_paper_name,json=sales_paper_name,proto3" json:"sales_paper_name"`
+	ExamStatus            int32  `protobuf:"varint,3,opt,name=exam_status,json=exam_status,proto3" json:"exam_status"`
+	OpenAt                string `protobuf:"bytes,4,opt,name=open_at,json=open_at,proto3" json:"open_at"`
+	CloseAt               string `protobuf:"bytes,5,opt,name=close_at,json=close_at,proto3" json:"close_at"`
+	TimeLimit             int32  `protobuf:"varint,6,opt,name=time_limit,json=time_limit,proto3" json:"time_limit"`
+	IsPractice            bool   `protobuf:"varint,7,opt,name=is_practice,json=is_practice,proto3" json:"is_practice"`
 }
 
-func (x *ExamQuestionRecordResponse) Reset() {
-	*x = ExamQuestionRecordResponse{}
+func (x *ExamData) Reset() {
+	*x = ExamData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -906,13 +1038,13 @@ func (x *ExamQuestionRecordResponse) Reset() {
 	}
 }
 
-func (x *ExamQuestionRecordResponse) String() string {
+func (x *ExamData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamQuestionRecordResponse) ProtoMessage() {}
+func (*ExamData) ProtoMessage() {}
 
-func (x *ExamQuestionRecordResponse) ProtoReflect() protoreflect.Message {
+func (x *ExamData) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,28 +1056,71 @@ func (x *ExamQuestionRecordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamQuestionRecordResponse.ProtoReflect.Descriptor instead.
-func (*ExamQuestionRecordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExamData.ProtoReflect.Descriptor instead.
+func (*ExamData) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ExamQuestionRecordResponse) GetAnswerData() []*QuestionAnswerData {
+func (x *ExamData) GetExamineeAssociationId() string {
 	if x != nil {
-		return x.AnswerData
+		return x.ExamineeAssociationId
 	}
-	return nil
+	return ""
 }
 
-type HeartbeatAndSaveRequest struct {
+func (x *ExamData) GetSalesPaperName() string {
+	if x != nil {
+		return x.SalesPaperName
+	}
+	return ""
+}
+
+func (x *ExamData) GetExamStatus() int32 {
+	if x != nil {
+		return x.ExamStatus
+	}
+	return 0
+}
+
+func (x *ExamData) GetOpenAt() string {
+	if x != nil {
+		return x.OpenAt
+	}
+	return ""
+}
+
+func (x *ExamData) GetCloseAt() string {
+	if x != nil {
+		return x.CloseAt
+	}
+	return ""
+}
+
+func (x *ExamData) GetTimeLimit() int32 {
+	if x != nil {
+		return x.TimeLimit
+	}
+	return 0
+}
+
+func (x *ExamData) GetIsPractice() bool {
+	if x != nil {
+		return x.IsPractice
+	}
+	return false
+}
+
+type StartExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnswerData []*QuestionAnswerData `protobuf:"bytes,1,rep,name=answer_data,json=answer_data,proto3" json:"answer_data"`
+	ExamineeAssociationId string `protobuf:"bytes,1,opt,name=examinee_association_id,json=examinee_association_id,proto3" json:"examinee_association_id"`
+	Restart               bool   `protobuf:"varint,2,opt,name=restart,json=restart,proto3" json:"restart"`
 }
 
-func (x *HeartbeatAndSaveRequest) Reset() {
-	*x = HeartbeatAndSaveRequest{}
+func (x *StartExamRequest) Reset() {
+	*x = StartExamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -953,13 +1128,13 @@ func (x *HeartbeatAndSaveRequest) Reset() {
 	}
 }
 
-func (x *HeartbeatAndSaveRequest) String() string {
+func (x *StartExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartbeatAndSaveRequest) ProtoMessage() {}
+func (*StartExamRequest) ProtoMessage() {}
 
-func (x *HeartbeatAndSaveRequest) ProtoReflect() protoreflect.Message {
+func (x *StartExamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,30 +1146,40 @@ func (x *HeartbeatAndSaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartbeatAndSaveRequest.ProtoReflect.Descriptor instead.
-func (*HeartbeatAndSaveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartExamRequest.ProtoReflect.Descriptor instead.
+func (*StartExamRequest) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *HeartbeatAndSaveRequest) GetAnswerData() []*QuestionAnswerData {
+func (x *StartExamRequest) GetExamineeAssociationId() string {
 	if x != nil {
-		return x.AnswerData
+		return x.ExamineeAssociationId
 	}
-	return nil
+	return ""
 }
 
-type HeartbeatAndSaveResponse struct {
+func (x *StartExamRequest) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+type StartExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalDuration int32 `protobuf:"varint,1,opt,name=total_duration,json=total_duration,proto3" json:"total_duration"`
-	UsedDuration  int32 `protobuf:"varint,2,opt,name=used_duration,json=used_duration,proto3" json:"used_duration"`
-	Remaining     int32 `protobuf:"varint,3,opt,name=remaining,json=remaining,proto3" json:"remaining"`
+	ExamToken     string `protobuf:"bytes,1,opt,name=exam_token,json=exam_token,proto3" json:"exam_token"`
+	TotalDuration int32  `protobuf:"varint,2,opt,name=total_duration,json=total_duration,proto3" json:"total_duration"`
+	UsedDuration  int32  `protobuf:"varint,3,opt,name=used_duration,json=used_duration,proto3" json:"used_duration"`
+	Remaining     int32  `protobuf:"varint,4,opt,name=remaining,json=remaining,proto3" json:"remaining"`
+	AnsweredCount int32  `protobuf:"varint,5,opt,name=answered_count,json=answered_count,proto3" json:"answered_count"`
+	TotalCount    int32  `protobuf:"varint,6,opt,name=total_count,json=total_count,proto3" json:"total_count"`
 }
 
-func (x *HeartbeatAndSaveResponse) Reset() {
-	*x = HeartbeatAndSaveResponse{}
+func (x *StartExamResponse) Reset() {
+	*x = StartExamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,13 +1187,13 @@ func (x *HeartbeatAndSaveResponse) Reset() {
 	}
 }
 
-func (x *HeartbeatAndSaveResponse) String() string {
+func (x *StartExamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartbeatAndSaveResponse) ProtoMessage() {}
+func (*StartExamResponse) ProtoMessage() {}
 
-func (x *HeartbeatAndSaveResponse) ProtoReflect() protoreflect.Message {
+func (x *StartExamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,43 +1205,68 @@ func (x *HeartbeatAndSaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartbeatAndSaveResponse.ProtoReflect.Descriptor instead.
-func (*HeartbeatAndSaveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartExamResponse.ProtoReflect.Descriptor instead.
+func (*StartExamResponse) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *HeartbeatAndSaveResponse) GetTotalDuration() int32 {
+func (x *StartExamResponse) GetExamToken() string {
+	if x != nil {
+		return x.ExamToken
+	}
+	return ""
+}
+
+func (x *StartExamResponse) GetTotalDuration() int32 {
 	if x != nil {
 		return x.TotalDuration
 	}
 	return 0
 }
 
-func (x *HeartbeatAndSaveResponse) GetUsedDuration() int32 {
+func (x *StartExamResponse) GetUsedDuration() int32 {
 	if x != nil {
 		return x.UsedDuration
 	}
 	return 0
 }
 
-func (x *HeartbeatAndSaveResponse) GetRemaining() int32 {
+func (x *StartExamResponse) GetRemaining() int32 {
 	if x != nil {
 		return x.Remaining
 	}
 	return 0
 }
 
-type QuestionAnswerData struct {
+func (x *StartExamResponse) GetAnsweredCount() int32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *StartExamResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type QuestionData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId              string   `protobuf:"bytes,1,opt,name=question_id,json=question_id,proto3" json:"question_id"`
-	OptionsSerialNumberData []string `protobuf:"bytes,2,rep,name=options_serial_number_data,json=options_serial_number_data,proto3" json:"options_serial_number_data"`
+	QuestionId          string                `protobuf:"bytes,1,opt,name=question_id,json=question_id,proto3" json:"question_id"`
+	Title               string                `protobuf:"bytes,2,opt,name=title,json=title,proto3" json:"title"`
+	QuestionTypeId      QuestionType          `protobuf:"varint,3,opt,name=question_type_id,json=question_type_id,proto3,enum=exam_api.v1.QuestionType" json:"question_type_id"`
+	Order               int32                 `protobuf:"varint,4,opt,name=order,json=order,proto3" json:"order"`
+	QuestionOptionsData []*QuestionOptionData `protobuf:"bytes,5,rep,name=question_options_data,json=question_options_data,proto3" json:"question_options_data"`
+	Attachments         []*Attachment         `protobuf:"bytes,6,rep,name=attachments,json=attachments,proto3" json:"attachments"`
 }
 
-func (x *QuestionAnswerData) Reset() {
-	*x = QuestionAnswerData{}
+func (x *QuestionData) Reset() {
+	*x = QuestionData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1064,13 +1274,13 @@ func (x *QuestionAnswerData) Reset() {
 	}
 }
 
-func (x *QuestionAnswerData) String() string {
+func (x *QuestionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionAnswerData) ProtoMessage() {}
+func (*QuestionData) ProtoMessage() {}
 
-func (x *QuestionAnswerData) ProtoReflect() protoreflect.Message {
+func (x *QuestionData) ProtoReflect() protoreflect.Message {
 	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,50 +1292,1580 @@ func (x *QuestionAnswerData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionAnswerData.ProtoReflect.Descriptor instead.
-func (*QuestionAnswerData) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuestionData.ProtoReflect.Descriptor instead.
+func (*QuestionData) Descriptor() ([]byte, []int) {
 	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QuestionAnswerData) GetQuestionId() string {
+func (x *QuestionData) GetQuestionId() string {
 	if x != nil {
 		return x.QuestionId
 	}
 	return ""
 }
 
-func (x *QuestionAnswerData) GetOptionsSerialNumberData() []string {
+func (x *QuestionData) GetTitle() string {
 	if x != nil {
-		return x.OptionsSerialNumberData
+		return x.Title
 	}
-	return nil
+	return ""
+}
+
+func (x *QuestionData) GetQuestionTypeId() QuestionType {
+	if x != nil {
+		return x.QuestionTypeId
+	}
+	return QuestionType_RadioChoice
+}
+
+func (x *QuestionData) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *QuestionData) GetQuestionOptionsData() []*QuestionOptionData {
+	if x != nil {
+		return x.QuestionOptionsData
+	}
+	return nil
+}
+
+func (x *QuestionData) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type QuestionOptionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionOptionId string        `protobuf:"bytes,1,opt,name=question_option_id,json=question_option_id,proto3" json:"question_option_id"`
+	Description      string        `protobuf:"bytes,2,opt,name=description,json=description,proto3" json:"description"`
+	SerialNumber     string        `protobuf:"bytes,3,opt,name=serial_number,json=serial_number,proto3" json:"serial_number"`
+	Attachments      []*Attachment `protobuf:"bytes,4,rep,name=attachments,json=attachments,proto3" json:"attachments"`
+}
+
+func (x *QuestionOptionData) Reset() {
+	*x = QuestionOptionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionOptionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionOptionData) ProtoMessage() {}
+
+func (x *QuestionOptionData) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionOptionData.ProtoReflect.Descriptor instead.
+func (*QuestionOptionData) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuestionOptionData) GetQuestionOptionId() string {
+	if x != nil {
+		return x.QuestionOptionId
+	}
+	return ""
+}
+
+func (x *QuestionOptionData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuestionOptionData) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *QuestionOptionData) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType MediaType `protobuf:"varint,1,opt,name=media_type,json=media_type,proto3,enum=exam_api.v1.MediaType" json:"media_type"`
+	Url       string    `protobuf:"bytes,2,opt,name=url,json=url,proto3" json:"url"`
+	ExpiresAt int64     `protobuf:"varint,3,opt,name=expires_at,json=expires_at,proto3" json:"expires_at"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Attachment) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MediaUnknown
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ExamQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId string `protobuf:"bytes,1,opt,name=section_id,json=section_id,proto3" json:"section_id"`
+}
+
+func (x *ExamQuestionRequest) Reset() {
+	*x = ExamQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamQuestionRequest) ProtoMessage() {}
+
+func (x *ExamQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamQuestionRequest.ProtoReflect.Descriptor instead.
+func (*ExamQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExamQuestionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type ExamQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionData []*QuestionData `protobuf:"bytes,6,rep,name=question_data,json=question_data,proto3" json:"question_data"`
+	Section      *SectionData    `protobuf:"bytes,7,opt,name=section,json=section,proto3" json:"section"`
+	Sections     []*SectionData  `protobuf:"bytes,8,rep,name=sections,json=sections,proto3" json:"sections"`
+}
+
+func (x *ExamQuestionResponse) Reset() {
+	*x = ExamQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamQuestionResponse) ProtoMessage() {}
+
+func (x *ExamQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamQuestionResponse.ProtoReflect.Descriptor instead.
+func (*ExamQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExamQuestionResponse) GetQuestionData() []*QuestionData {
+	if x != nil {
+		return x.QuestionData
+	}
+	return nil
+}
+
+func (x *ExamQuestionResponse) GetSection() *SectionData {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *ExamQuestionResponse) GetSections() []*SectionData {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type SectionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId    string        `protobuf:"bytes,1,opt,name=section_id,json=section_id,proto3" json:"section_id"`
+	Name         string        `protobuf:"bytes,2,opt,name=name,json=name,proto3" json:"name"`
+	Instructions string        `protobuf:"bytes,3,opt,name=instructions,json=instructions,proto3" json:"instructions"`
+	Order        int32         `protobuf:"varint,4,opt,name=order,json=order,proto3" json:"order"`
+	TimeLimit    int32         `protobuf:"varint,5,opt,name=time_limit,json=time_limit,proto3" json:"time_limit"`
+	Remaining    int32         `protobuf:"varint,6,opt,name=remaining,json=remaining,proto3" json:"remaining"`
+	Status       SectionStatus `protobuf:"varint,7,opt,name=status,json=status,proto3,enum=exam_api.v1.SectionStatus" json:"status"`
+}
+
+func (x *SectionData) Reset() {
+	*x = SectionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionData) ProtoMessage() {}
+
+func (x *SectionData) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionData.ProtoReflect.Descriptor instead.
+func (*SectionData) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SectionData) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SectionData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SectionData) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *SectionData) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *SectionData) GetTimeLimit() int32 {
+	if x != nil {
+		return x.TimeLimit
+	}
+	return 0
+}
+
+func (x *SectionData) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *SectionData) GetStatus() SectionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SectionStatus_SectionNotStarted
+}
+
+type NextQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer *QuestionAnswerData `protobuf:"bytes,1,opt,name=answer,json=answer,proto3" json:"answer"`
+}
+
+func (x *NextQuestionRequest) Reset() {
+	*x = NextQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextQuestionRequest) ProtoMessage() {}
+
+func (x *NextQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextQuestionRequest.ProtoReflect.Descriptor instead.
+func (*NextQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NextQuestionRequest) GetAnswer() *QuestionAnswerData {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type NextQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question      *QuestionData `protobuf:"bytes,1,opt,name=question,json=question,proto3" json:"question"`
+	Finished      bool          `protobuf:"varint,2,opt,name=finished,json=finished,proto3" json:"finished"`
+	AnsweredCount int32         `protobuf:"varint,3,opt,name=answered_count,json=answered_count,proto3" json:"answered_count"`
+}
+
+func (x *NextQuestionResponse) Reset() {
+	*x = NextQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextQuestionResponse) ProtoMessage() {}
+
+func (x *NextQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextQuestionResponse.ProtoReflect.Descriptor instead.
+func (*NextQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NextQuestionResponse) GetQuestion() *QuestionData {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *NextQuestionResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *NextQuestionResponse) GetAnsweredCount() int32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+type SubmitSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId string `protobuf:"bytes,1,opt,name=section_id,json=section_id,proto3" json:"section_id"`
+}
+
+func (x *SubmitSectionRequest) Reset() {
+	*x = SubmitSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSectionRequest) ProtoMessage() {}
+
+func (x *SubmitSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSectionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSectionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubmitSectionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type SubmitSectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextSectionId string `protobuf:"bytes,1,opt,name=next_section_id,json=next_section_id,proto3" json:"next_section_id"`
+}
+
+func (x *SubmitSectionResponse) Reset() {
+	*x = SubmitSectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSectionResponse) ProtoMessage() {}
+
+func (x *SubmitSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSectionResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSectionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubmitSectionResponse) GetNextSectionId() string {
+	if x != nil {
+		return x.NextSectionId
+	}
+	return ""
+}
+
+type ExamQuestionRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExamQuestionRecordRequest) Reset() {
+	*x = ExamQuestionRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamQuestionRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamQuestionRecordRequest) ProtoMessage() {}
+
+func (x *ExamQuestionRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamQuestionRecordRequest.ProtoReflect.Descriptor instead.
+func (*ExamQuestionRecordRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{25}
+}
+
+type ExamQuestionRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerData []*QuestionAnswerData `protobuf:"bytes,6,rep,name=answer_data,json=answer_data,proto3" json:"answer_data"`
+}
+
+func (x *ExamQuestionRecordResponse) Reset() {
+	*x = ExamQuestionRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamQuestionRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamQuestionRecordResponse) ProtoMessage() {}
+
+func (x *ExamQuestionRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamQuestionRecordResponse.ProtoReflect.Descriptor instead.
+func (*ExamQuestionRecordResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExamQuestionRecordResponse) GetAnswerData() []*QuestionAnswerData {
+	if x != nil {
+		return x.AnswerData
+	}
+	return nil
+}
+
+type HeartbeatAndSaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerData []*QuestionAnswerData `protobuf:"bytes,1,rep,name=answer_data,json=answer_data,proto3" json:"answer_data"`
+}
+
+func (x *HeartbeatAndSaveRequest) Reset() {
+	*x = HeartbeatAndSaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatAndSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatAndSaveRequest) ProtoMessage() {}
+
+func (x *HeartbeatAndSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatAndSaveRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatAndSaveRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HeartbeatAndSaveRequest) GetAnswerData() []*QuestionAnswerData {
+	if x != nil {
+		return x.AnswerData
+	}
+	return nil
+}
+
+type HeartbeatAndSaveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalDuration int32 `protobuf:"varint,1,opt,name=total_duration,json=total_duration,proto3" json:"total_duration"`
+	UsedDuration  int32 `protobuf:"varint,2,opt,name=used_duration,json=used_duration,proto3" json:"used_duration"`
+	Remaining     int32 `protobuf:"varint,3,opt,name=remaining,json=remaining,proto3" json:"remaining"`
+	AnsweredCount int32 `protobuf:"varint,4,opt,name=answered_count,json=answered_count,proto3" json:"answered_count"`
+	TotalCount    int32 `protobuf:"varint,5,opt,name=total_count,json=total_count,proto3" json:"total_count"`
+}
+
+func (x *HeartbeatAndSaveResponse) Reset() {
+	*x = HeartbeatAndSaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatAndSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatAndSaveResponse) ProtoMessage() {}
+
+func (x *HeartbeatAndSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatAndSaveResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatAndSaveResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HeartbeatAndSaveResponse) GetTotalDuration() int32 {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return 0
+}
+
+func (x *HeartbeatAndSaveResponse) GetUsedDuration() int32 {
+	if x != nil {
+		return x.UsedDuration
+	}
+	return 0
+}
+
+func (x *HeartbeatAndSaveResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *HeartbeatAndSaveResponse) GetAnsweredCount() int32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *HeartbeatAndSaveResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type QuestionAnswerData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId              string   `protobuf:"bytes,1,opt,name=question_id,json=question_id,proto3" json:"question_id"`
+	OptionsSerialNumberData []string `protobuf:"bytes,2,rep,name=options_serial_number_data,json=options_serial_number_data,proto3" json:"options_serial_number_data"`
+	TimeSpentMs             int64    `protobuf:"varint,3,opt,name=time_spent_ms,json=time_spent_ms,proto3" json:"time_spent_ms"`
+	ChangeCount             int32    `protobuf:"varint,4,opt,name=change_count,json=change_count,proto3" json:"change_count"`
+	RankingSerialNumberData []string `protobuf:"bytes,5,rep,name=ranking_serial_number_data,json=ranking_serial_number_data,proto3" json:"ranking_serial_number_data"`
+	MostSerialNumber        string   `protobuf:"bytes,6,opt,name=most_serial_number,json=most_serial_number,proto3" json:"most_serial_number"`
+	LeastSerialNumber       string   `protobuf:"bytes,7,opt,name=least_serial_number,json=least_serial_number,proto3" json:"least_serial_number"`
+	TextAnswer              string   `protobuf:"bytes,8,opt,name=text_answer,json=text_answer,proto3" json:"text_answer"`
+}
+
+func (x *QuestionAnswerData) Reset() {
+	*x = QuestionAnswerData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionAnswerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionAnswerData) ProtoMessage() {}
+
+func (x *QuestionAnswerData) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionAnswerData.ProtoReflect.Descriptor instead.
+func (*QuestionAnswerData) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QuestionAnswerData) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionAnswerData) GetOptionsSerialNumberData() []string {
+	if x != nil {
+		return x.OptionsSerialNumberData
+	}
+	return nil
+}
+
+func (x *QuestionAnswerData) GetTimeSpentMs() int64 {
+	if x != nil {
+		return x.TimeSpentMs
+	}
+	return 0
+}
+
+func (x *QuestionAnswerData) GetChangeCount() int32 {
+	if x != nil {
+		return x.ChangeCount
+	}
+	return 0
+}
+
+func (x *QuestionAnswerData) GetRankingSerialNumberData() []string {
+	if x != nil {
+		return x.RankingSerialNumberData
+	}
+	return nil
+}
+
+func (x *QuestionAnswerData) GetMostSerialNumber() string {
+	if x != nil {
+		return x.MostSerialNumber
+	}
+	return ""
+}
+
+func (x *QuestionAnswerData) GetLeastSerialNumber() string {
+	if x != nil {
+		return x.LeastSerialNumber
+	}
+	return ""
+}
+
+func (x *QuestionAnswerData) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+type SubmitExamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerData []*QuestionAnswerData `protobuf:"bytes,2,rep,name=answer_data,json=answer_data,proto3" json:"answer_data"`
+}
+
+func (x *SubmitExamRequest) Reset() {
+	*x = SubmitExamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitExamRequest) ProtoMessage() {}
+
+func (x *SubmitExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitExamRequest.ProtoReflect.Descriptor instead.
+func (*SubmitExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitExamRequest) GetAnswerData() []*QuestionAnswerData {
+	if x != nil {
+		return x.AnswerData
+	}
+	return nil
+}
+
+type SubmitExamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitExamResponse) Reset() {
+	*x = SubmitExamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitExamResponse) ProtoMessage() {}
+
+func (x *SubmitExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitExamResponse.ProtoReflect.Descriptor instead.
+func (*SubmitExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{31}
+}
+
+type ReportExamEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ClientExamEvent `protobuf:"bytes,1,rep,name=events,json=events,proto3" json:"events"`
+}
+
+func (x *ReportExamEventsRequest) Reset() {
+	*x = ReportExamEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportExamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportExamEventsRequest) ProtoMessage() {}
+
+func (x *ReportExamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportExamEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReportExamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReportExamEventsRequest) GetEvents() []*ClientExamEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ClientExamEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType  string            `protobuf:"bytes,1,opt,name=event_type,json=event_type,proto3" json:"event_type"`
+	ClientTime int64             `protobuf:"varint,2,opt,name=client_time,json=client_time,proto3" json:"client_time"`
+	Meta       map[string]string `protobuf:"bytes,3,rep,name=meta,json=meta,proto3" json:"meta" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ClientExamEvent) Reset() {
+	*x = ClientExamEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientExamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientExamEvent) ProtoMessage() {}
+
+func (x *ClientExamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientExamEvent.ProtoReflect.Descriptor instead.
+func (*ClientExamEvent) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClientExamEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ClientExamEvent) GetClientTime() int64 {
+	if x != nil {
+		return x.ClientTime
+	}
+	return 0
+}
+
+func (x *ClientExamEvent) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ReportExamEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accepted int32 `protobuf:"varint,1,opt,name=accepted,json=accepted,proto3" json:"accepted"`
+}
+
+func (x *ReportExamEventsResponse) Reset() {
+	*x = ReportExamEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportExamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportExamEventsResponse) ProtoMessage() {}
+
+func (x *ReportExamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportExamEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReportExamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReportExamEventsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+type ExamAnswerTimelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssociationId string `protobuf:"bytes,1,opt,name=association_id,json=association_id,proto3" json:"association_id"`
+}
+
+func (x *ExamAnswerTimelineRequest) Reset() {
+	*x = ExamAnswerTimelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamAnswerTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamAnswerTimelineRequest) ProtoMessage() {}
+
+func (x *ExamAnswerTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamAnswerTimelineRequest.ProtoReflect.Descriptor instead.
+func (*ExamAnswerTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExamAnswerTimelineRequest) GetAssociationId() string {
+	if x != nil {
+		return x.AssociationId
+	}
+	return ""
+}
+
+type ExamAnswerTimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*AnswerRevision `protobuf:"bytes,1,rep,name=revisions,json=revisions,proto3" json:"revisions"`
+}
+
+func (x *ExamAnswerTimelineResponse) Reset() {
+	*x = ExamAnswerTimelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamAnswerTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamAnswerTimelineResponse) ProtoMessage() {}
+
+func (x *ExamAnswerTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamAnswerTimelineResponse.ProtoReflect.Descriptor instead.
+func (*ExamAnswerTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExamAnswerTimelineResponse) GetRevisions() []*AnswerRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type AnswerRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId                     string   `protobuf:"bytes,1,opt,name=question_id,json=question_id,proto3" json:"question_id"`
+	Seq                            int32    `protobuf:"varint,2,opt,name=seq,json=seq,proto3" json:"seq"`
+	OptionsSerialNumberData        []string `protobuf:"bytes,3,rep,name=options_serial_number_data,json=options_serial_number_data,proto3" json:"options_serial_number_data"`
+	TimeSpentMs                    int64    `protobuf:"varint,4,opt,name=time_spent_ms,json=time_spent_ms,proto3" json:"time_spent_ms"`
+	ChangeCount                    int32    `protobuf:"varint,5,opt,name=change_count,json=change_count,proto3" json:"change_count"`
+	SessionId                      string   `protobuf:"bytes,6,opt,name=session_id,json=session_id,proto3" json:"session_id"`
+	ReceivedAt                     int64    `protobuf:"varint,7,opt,name=received_at,json=received_at,proto3" json:"received_at"`
+	TextAnswer                     string   `protobuf:"bytes,8,opt,name=text_answer,json=text_answer,proto3" json:"text_answer"`
+	DisplayOptionsSerialNumberData []string `protobuf:"bytes,9,rep,name=display_options_serial_number_data,json=display_options_serial_number_data,proto3" json:"display_options_serial_number_data"`
+}
+
+func (x *AnswerRevision) Reset() {
+	*x = AnswerRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerRevision) ProtoMessage() {}
+
+func (x *AnswerRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerRevision.ProtoReflect.Descriptor instead.
+func (*AnswerRevision) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AnswerRevision) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerRevision) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AnswerRevision) GetOptionsSerialNumberData() []string {
+	if x != nil {
+		return x.OptionsSerialNumberData
+	}
+	return nil
+}
+
+func (x *AnswerRevision) GetTimeSpentMs() int64 {
+	if x != nil {
+		return x.TimeSpentMs
+	}
+	return 0
+}
+
+func (x *AnswerRevision) GetChangeCount() int32 {
+	if x != nil {
+		return x.ChangeCount
+	}
+	return 0
+}
+
+func (x *AnswerRevision) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AnswerRevision) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+func (x *AnswerRevision) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+func (x *AnswerRevision) GetDisplayOptionsSerialNumberData() []string {
+	if x != nil {
+		return x.DisplayOptionsSerialNumberData
+	}
+	return nil
+}
+
+type GetGradingQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageIndex int32 `protobuf:"varint,1,opt,name=page_index,json=page_index,proto3" json:"page_index"`
+	PageSize  int32 `protobuf:"varint,2,opt,name=page_size,json=page_size,proto3" json:"page_size"`
+}
+
+func (x *GetGradingQueueRequest) Reset() {
+	*x = GetGradingQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradingQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingQueueRequest) ProtoMessage() {}
+
+func (x *GetGradingQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingQueueRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetGradingQueueRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *GetGradingQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetGradingQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*GradingItem `protobuf:"bytes,1,rep,name=items,json=items,proto3" json:"items"`
+	Total int64          `protobuf:"varint,2,opt,name=total,json=total,proto3" json:"total"`
+}
+
+func (x *GetGradingQueueResponse) Reset() {
+	*x = GetGradingQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradingQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingQueueResponse) ProtoMessage() {}
+
+func (x *GetGradingQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetGradingQueueResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetGradingQueueResponse) GetItems() []*GradingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetGradingQueueResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GradingItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerId         string  `protobuf:"bytes,1,opt,name=answer_id,json=answer_id,proto3" json:"answer_id"`
+	ExamineeAnswerId string  `protobuf:"bytes,2,opt,name=examinee_answer_id,json=examinee_answer_id,proto3" json:"examinee_answer_id"`
+	QuestionId       string  `protobuf:"bytes,3,opt,name=question_id,json=question_id,proto3" json:"question_id"`
+	Title            string  `protobuf:"bytes,4,opt,name=title,json=title,proto3" json:"title"`
+	FullScore        float64 `protobuf:"fixed64,5,opt,name=full_score,json=full_score,proto3" json:"full_score"`
+	TextAnswer       string  `protobuf:"bytes,6,opt,name=text_answer,json=text_answer,proto3" json:"text_answer"`
+}
+
+func (x *GradingItem) Reset() {
+	*x = GradingItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingItem) ProtoMessage() {}
+
+func (x *GradingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingItem.ProtoReflect.Descriptor instead.
+func (*GradingItem) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GradingItem) GetAnswerId() string {
+	if x != nil {
+		return x.AnswerId
+	}
+	return ""
+}
+
+func (x *GradingItem) GetExamineeAnswerId() string {
+	if x != nil {
+		return x.ExamineeAnswerId
+	}
+	return ""
 }
 
-type SubmitExamRequest struct {
+func (x *GradingItem) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GradingItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GradingItem) GetFullScore() float64 {
+	if x != nil {
+		return x.FullScore
+	}
+	return 0
+}
+
+func (x *GradingItem) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+type GradeAnswerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnswerData []*QuestionAnswerData `protobuf:"bytes,2,rep,name=answer_data,json=answer_data,proto3" json:"answer_data"`
+	AnswerId string  `protobuf:"bytes,1,opt,name=answer_id,json=answer_id,proto3" json:"answer_id"`
+	Score    float64 `protobuf:"fixed64,2,opt,name=score,json=score,proto3" json:"score"`
 }
 
-func (x *SubmitExamRequest) Reset() {
-	*x = SubmitExamRequest{}
+func (x *GradeAnswerRequest) Reset() {
+	*x = GradeAnswerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[16]
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitExamRequest) String() string {
+func (x *GradeAnswerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitExamRequest) ProtoMessage() {}
+func (*GradeAnswerRequest) ProtoMessage() {}
 
-func (x *SubmitExamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[16]
+func (x *GradeAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,41 +2876,226 @@ func (x *SubmitExamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitExamRequest.ProtoReflect.Descriptor instead.
-func (*SubmitExamRequest) Descriptor() ([]byte, []int) {
-	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GradeAnswerRequest.ProtoReflect.Descriptor instead.
+func (*GradeAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SubmitExamRequest) GetAnswerData() []*QuestionAnswerData {
+func (x *GradeAnswerRequest) GetAnswerId() string {
 	if x != nil {
-		return x.AnswerData
+		return x.AnswerId
+	}
+	return ""
+}
+
+func (x *GradeAnswerRequest) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GradeAnswerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining int64 `protobuf:"varint,1,opt,name=remaining,json=remaining,proto3" json:"remaining"`
+}
+
+func (x *GradeAnswerResponse) Reset() {
+	*x = GradeAnswerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAnswerResponse) ProtoMessage() {}
+
+func (x *GradeAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeAnswerResponse.ProtoReflect.Descriptor instead.
+func (*GradeAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GradeAnswerResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type GetExamResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssociationId string `protobuf:"bytes,1,opt,name=association_id,json=association_id,proto3" json:"association_id"`
+}
+
+func (x *GetExamResultRequest) Reset() {
+	*x = GetExamResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExamResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamResultRequest) ProtoMessage() {}
+
+func (x *GetExamResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamResultRequest.ProtoReflect.Descriptor instead.
+func (*GetExamResultRequest) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetExamResultRequest) GetAssociationId() string {
+	if x != nil {
+		return x.AssociationId
+	}
+	return ""
+}
+
+type GetExamResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visibility     ResultVisibility   `protobuf:"varint,1,opt,name=visibility,json=visibility,proto3,enum=exam_api.v1.ResultVisibility" json:"visibility"`
+	SalesPaperName string             `protobuf:"bytes,2,opt,name=sales_paper_name,json=sales_paper_name,proto3" json:"sales_paper_name"`
+	Score          float64            `protobuf:"fixed64,3,opt,name=score,json=score,proto3" json:"score"`
+	Comment        string             `protobuf:"bytes,4,opt,name=comment,json=comment,proto3" json:"comment"`
+	Dimensions     []*DimensionResult `protobuf:"bytes,5,rep,name=dimensions,json=dimensions,proto3" json:"dimensions"`
+}
+
+func (x *GetExamResultResponse) Reset() {
+	*x = GetExamResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExamResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamResultResponse) ProtoMessage() {}
+
+func (x *GetExamResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamResultResponse.ProtoReflect.Descriptor instead.
+func (*GetExamResultResponse) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetExamResultResponse) GetVisibility() ResultVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return ResultVisibility_ResultHidden
+}
+
+func (x *GetExamResultResponse) GetSalesPaperName() string {
+	if x != nil {
+		return x.SalesPaperName
+	}
+	return ""
+}
+
+func (x *GetExamResultResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetExamResultResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GetExamResultResponse) GetDimensions() []*DimensionResult {
+	if x != nil {
+		return x.Dimensions
 	}
 	return nil
 }
 
-type SubmitExamResponse struct {
+type DimensionResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DimensionId   string  `protobuf:"bytes,1,opt,name=dimension_id,json=dimension_id,proto3" json:"dimension_id"`
+	Name          string  `protobuf:"bytes,2,opt,name=name,json=name,proto3" json:"name"`
+	StandardScore float64 `protobuf:"fixed64,3,opt,name=standard_score,json=standard_score,proto3" json:"standard_score"`
+	Comment       string  `protobuf:"bytes,4,opt,name=comment,json=comment,proto3" json:"comment"`
 }
 
-func (x *SubmitExamResponse) Reset() {
-	*x = SubmitExamResponse{}
+func (x *DimensionResult) Reset() {
+	*x = DimensionResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[17]
+		mi := &file_exam_api_v1_exam_modes_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitExamResponse) String() string {
+func (x *DimensionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitExamResponse) ProtoMessage() {}
+func (*DimensionResult) ProtoMessage() {}
 
-func (x *SubmitExamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[17]
+func (x *DimensionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_api_v1_exam_modes_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,9 +3106,37 @@ func (x *SubmitExamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitExamResponse.ProtoReflect.Descriptor instead.
-func (*SubmitExamResponse) Descriptor() ([]byte, []int) {
-	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DimensionResult.ProtoReflect.Descriptor instead.
+func (*DimensionResult) Descriptor() ([]byte, []int) {
+	return file_exam_api_v1_exam_modes_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DimensionResult) GetDimensionId() string {
+	if x != nil {
+		return x.DimensionId
+	}
+	return ""
+}
+
+func (x *DimensionResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DimensionResult) GetStandardScore() float64 {
+	if x != nil {
+		return x.StandardScore
+	}
+	return 0
+}
+
+func (x *DimensionResult) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
 }
 
 var File_exam_api_v1_exam_modes_proto protoreflect.FileDescriptor
@@ -1204,177 +3157,642 @@ var file_exam_api_v1_exam_modes_proto_rawDesc = []byte{
 	0x61, 0x73, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
 	0x92, 0x41, 0x19, 0x2a, 0x06, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x78, 0x0a, 0x80, 0x01, 0x06,
 	0xd2, 0x01, 0x09, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x70, 0x61,
-	0x73, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x64, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0e, 0x92, 0x41, 0x0b, 0x2a, 0x09, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0x90, 0x8d, 0x52,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x2a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3d, 0x22, 0x7a, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b,
-	0x2a, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x3a, 0x01, 0x31, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x2a,
-	0x09, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x45, 0x78, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x42,
-	0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe5, 0xbe, 0x85, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5,
-	0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0xdb, 0x01, 0x0a, 0x08, 0x45, 0x78, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x47, 0x0a, 0x17, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x2a, 0x08, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0x69, 0x64,
-	0x52, 0x17, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x10, 0x73, 0x61, 0x6c,
-	0x65, 0x73, 0x5f, 0x70, 0x61, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7,
-	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x10, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x61,
-	0x70, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0x92,
-	0x41, 0x22, 0x2a, 0x20, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x31, 0xe6, 0x9c,
-	0xaa, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0xef, 0xbc, 0x8c, 0x32, 0xe5, 0xb7, 0xb2, 0xe5, 0xae,
-	0x8c, 0xe6, 0x88, 0x90, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x75, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x17, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
-	0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x2a, 0x08, 0xe5, 0x85, 0xb3,
-	0xe8, 0x81, 0x94, 0x69, 0x64, 0xd2, 0x01, 0x17, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65,
-	0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52,
+	0x73, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x2a, 0x09, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0x90, 0x8d,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x2a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3d, 0x12, 0x36,
+	0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0xe5, 0x88, 0xb7, 0xe6,
+	0x96, 0xb0, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x09, 0xe7,
+	0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0x90, 0x8d, 0xd2, 0x01, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x09, 0xe7, 0x99, 0xbb, 0xe5,
+	0xbd, 0x95, 0xe5, 0x90, 0x8d, 0xd2, 0x01, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x2a, 0x09, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7,
+	0xa0, 0x81, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0xa1, 0x01, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0x92, 0x41, 0x0b, 0x2a, 0x09, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0x90, 0x8d, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x2a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x2a, 0x0b, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x70, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x2a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x0d,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41,
+	0x25, 0x2a, 0x23, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc,
+	0x8c, 0xe4, 0xbc, 0xa0, 0xe5, 0x85, 0xa5, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x80, 0xe5, 0xb9, 0xb6,
+	0xe5, 0x90, 0x8a, 0xe9, 0x94, 0x80, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x2a, 0x06, 0xe9, 0xa1, 0xb5, 0xe7,
+	0xa0, 0x81, 0x3a, 0x01, 0x31, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x2a, 0x09, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1,
+	0xb5, 0xe6, 0x95, 0xb0, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x50,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f,
+	0xe5, 0xbe, 0x85, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52,
+	0x09, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06,
+	0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x88, 0x04,
+	0x0a, 0x08, 0x45, 0x78, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x17, 0x65, 0x78,
+	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x2a, 0x08, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0x69, 0x64, 0x52, 0x17, 0x65, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x10, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x70,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x2a, 0x0c, 0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
+	0x52, 0x10, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x20, 0xe7, 0x8a,
+	0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x31, 0xe6, 0x9c, 0xaa, 0xe5, 0xae, 0x8c, 0xe6, 0x88,
+	0x90, 0xef, 0xbc, 0x8c, 0x32, 0xe5, 0xb7, 0xb2, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0x52, 0x0b,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x07, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0x92, 0x41,
+	0x26, 0x2a, 0x24, 0xe5, 0xbc, 0x80, 0xe6, 0x94, 0xbe, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef,
+	0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8,
+	0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74,
+	0x12, 0x45, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x24, 0xe5, 0x85, 0xb3, 0xe9, 0x97, 0xad, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1,
+	0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x08, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1d, 0x92, 0x41, 0x1a,
+	0x2a, 0x18, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0xef, 0xbc,
+	0x88, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0xef, 0xbc, 0x89, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x60, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b,
+	0x2a, 0x39, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0xbb, 0x83, 0xe4, 0xb9, 0xa0, 0xe5, 0x8d,
+	0xb7, 0xef, 0xbc, 0x8c, 0xe7, 0xbb, 0x83, 0xe4, 0xb9, 0xa0, 0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xa1,
+	0xe5, 0x85, 0xa5, 0xe6, 0x88, 0x90, 0xe7, 0xbb, 0xa9, 0xef, 0xbc, 0x8c, 0xe5, 0x8f, 0xaf, 0xe9,
+	0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0x52, 0x0b, 0x69, 0x73, 0x5f,
+	0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x61, 0x0a,
 	0x17, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
-	0x0a, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x3c, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe8,
-	0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe6, 0x80, 0xbb, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40,
-	0x0a, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x15, 0xe8, 0x80, 0x83, 0xe8,
-	0xaf, 0x95, 0xe5, 0xb7, 0xb2, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
-	0xb4, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x12, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95,
-	0xe5, 0x89, 0xa9, 0xe4, 0xbd, 0x99, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x72, 0x65,
-	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xf4, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27,
+	0x92, 0x41, 0x24, 0x2a, 0x08, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0x69, 0x64, 0xd2, 0x01, 0x17,
+	0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x17, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
+	0x65, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x26, 0x92, 0x41, 0x23, 0x2a, 0x21, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe5, 0xbc,
+	0x80, 0xe5, 0xa7, 0x8b, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0xe7, 0xbb, 0x83, 0xe4, 0xb9, 0xa0,
+	0xe5, 0x8d, 0xb7, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x22, 0xf2, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41,
+	0x0d, 0x2a, 0x0b, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0a,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe6,
+	0x80, 0xbb, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x2a, 0x15, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0xb7, 0xb2, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x2a, 0x12, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x89, 0xa9, 0xe4, 0xbd, 0x99,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x3f, 0x0a, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x12,
+	0xe5, 0xb7, 0xb2, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe6,
+	0x95, 0xb0, 0x52, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0xa2,
+	0x98, 0xe7, 0x9b, 0xae, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9d, 0x04, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73,
 	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92,
 	0x41, 0x0d, 0x2a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52,
 	0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05,
 	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
 	0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x98, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x27, 0x92, 0x41, 0x24, 0x2a,
-	0x22, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x30, 0xe5, 0x8d, 0x95, 0xe9, 0x80, 0x89, 0xe3,
-	0x80, 0x81, 0x31, 0xe5, 0xa4, 0x9a, 0xe9, 0x80, 0x89, 0xe3, 0x80, 0x81, 0x32, 0xe5, 0x88, 0xa4,
-	0xe6, 0x96, 0xad, 0x52, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b,
-	0xae, 0xe5, 0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x6e,
-	0x0a, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x17,
-	0x92, 0x41, 0x14, 0x2a, 0x12, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe9, 0x80, 0x89, 0xe9, 0xa1,
-	0xb9, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcb,
-	0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x12, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x33,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0xaa, 0x01, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x63, 0x92, 0x41, 0x60,
+	0x2a, 0x5e, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x30, 0xe5, 0x8d, 0x95, 0xe9, 0x80, 0x89,
+	0xe3, 0x80, 0x81, 0x31, 0xe5, 0xa4, 0x9a, 0xe9, 0x80, 0x89, 0xe3, 0x80, 0x81, 0x32, 0xe5, 0x88,
+	0xa4, 0xe6, 0x96, 0xad, 0xe3, 0x80, 0x81, 0x33, 0xe9, 0x87, 0x8f, 0xe8, 0xa1, 0xa8, 0xe3, 0x80,
+	0x81, 0x34, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe3, 0x80, 0x81, 0x35, 0xe8, 0xbf, 0xab, 0xe9,
+	0x80, 0x89, 0xe3, 0x80, 0x81, 0x36, 0xe5, 0xa1, 0xab, 0xe7, 0xa9, 0xba, 0xe3, 0x80, 0x81, 0x37,
+	0xe6, 0x95, 0xb0, 0xe5, 0x80, 0xbc, 0xe3, 0x80, 0x81, 0x38, 0xe9, 0x97, 0xae, 0xe7, 0xad, 0x94,
+	0x52, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe5, 0xba,
+	0x8f, 0xe5, 0x8f, 0xb7, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x6e, 0x0a, 0x15, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x17, 0x92, 0x41, 0x14,
+	0x2a, 0x12, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9, 0xe5, 0x86,
+	0x85, 0xe5, 0xae, 0xb9, 0x52, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6a, 0x0a, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a, 0x2a,
+	0xe9, 0xa2, 0x98, 0xe5, 0xb9, 0xb2, 0xe9, 0x99, 0x84, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x88, 0xe5,
+	0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe3, 0x80, 0x81, 0xe9, 0x9f, 0xb3, 0xe9, 0xa2, 0x91, 0xe3, 0x80,
+	0x81, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xef, 0xbc, 0x89, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47,
+	0x0a, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a,
+	0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x52, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x2a, 0x0c, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9,
-	0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a,
-	0x0c, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9, 0xe5, 0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0x52, 0x0d, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13,
-	0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x6a, 0x0a, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x2a, 0x0c, 0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9,
-	0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x1b, 0x0a, 0x19, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x1a,
-	0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xe8, 0xae, 0xb0,
-	0xe5, 0xbd, 0x95, 0x52, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x69, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64,
-	0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x61,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x0b,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd1, 0x01, 0x0a, 0x18,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe6, 0x80, 0xbb,
-	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0x92,
-	0x41, 0x17, 0x2a, 0x15, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0xb7, 0xb2, 0xe4, 0xbd, 0xbf,
-	0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61,
-	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14,
-	0x2a, 0x12, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x89, 0xa9, 0xe4, 0xbd, 0x99, 0xe6, 0x97,
-	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22,
-	0x9b, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d,
-	0x2a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x0b, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x1a, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe9, 0x80, 0x89, 0xe9, 0xa1,
-	0xb9, 0x52, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x63, 0x0a,
-	0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0xe5, 0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a, 0x2a, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9,
+	0xe9, 0x99, 0x84, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x88, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe3,
+	0x80, 0x81, 0xe9, 0x9f, 0xb3, 0xe9, 0xa2, 0x91, 0xe3, 0x80, 0x81, 0xe8, 0xa7, 0x86, 0xe9, 0xa2,
+	0x91, 0xef, 0xbc, 0x89, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x99, 0x02, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x65, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2d, 0x92, 0x41,
+	0x2a, 0x2a, 0x28, 0xe9, 0x99, 0x84, 0xe4, 0xbb, 0xb6, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x3a,
+	0x31, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe3, 0x80, 0x81, 0x32, 0xe9, 0x9f, 0xb3, 0xe9, 0xa2,
+	0x91, 0xe3, 0x80, 0x81, 0x33, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0x52, 0x0a, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x2a, 0x39, 0xe5, 0xb8, 0xa6, 0xe7, 0xad,
+	0xbe, 0xe5, 0x90, 0x8d, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe5, 0x9c, 0xb0,
+	0xe5, 0x9d, 0x80, 0xef, 0xbc, 0x8c, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe9,
+	0x9c, 0x80, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0xa2,
+	0x98, 0xe7, 0x9b, 0xae, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x52, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x32, 0x92,
+	0x41, 0x2f, 0x2a, 0x2d, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80,
+	0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x88, 0xe6,
+	0xaf, 0xab, 0xe7, 0xa7, 0x92, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0xef, 0xbc,
+	0x89, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x6e, 0x0a,
+	0x13, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x2a, 0x32, 0xe5,
+	0x88, 0x86, 0xe9, 0x83, 0xa8, 0x69, 0x64, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8,
+	0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe4, 0xb8, 0x8d, 0xe4, 0xbc, 0xa0, 0xe6, 0x97, 0xb6, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x83,
+	0xa8, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xcf, 0x02,
+	0x0a, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x2a, 0x3c,
+	0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xef, 0xbc, 0x88, 0xe5,
+	0x88, 0x86, 0xe9, 0x83, 0xa8, 0xe8, 0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe5, 0x8f, 0xaa, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8,
+	0xe7, 0x9a, 0x84, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xef, 0xbc, 0x89, 0x52, 0x0d, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x60, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a, 0x27, 0xe5, 0xbd, 0x93,
+	0xe5, 0x89, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xef, 0xbc, 0x8c, 0xe8, 0xaf, 0x95, 0xe5,
+	0x8d, 0xb7, 0xe4, 0xb8, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xe6, 0x97, 0xb6, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a,
+	0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x15,
+	0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xe5, 0x8f, 0x8a, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xc3, 0x03, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x2d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x2a, 0x08, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8,
+	0x69, 0x64, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x2a, 0x0c, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x2a, 0x0c, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x52, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x2a, 0x0c, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xe5, 0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x2a, 0x1c,
+	0xe9, 0x99, 0x90, 0xe6, 0x97, 0xb6, 0xef, 0xbc, 0x88, 0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x89, 0xef,
+	0xbc, 0x8c, 0x30, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe6, 0x97, 0xb6, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2a, 0x92, 0x41, 0x27,
+	0x2a, 0x25, 0xe5, 0x89, 0xa9, 0xe4, 0xbd, 0x99, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0xef, 0xbc,
+	0x88, 0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x89, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90,
+	0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x30, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x71, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x3d,
+	0x92, 0x41, 0x3a, 0x2a, 0x38, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x30, 0xe6, 0x9c, 0xaa,
+	0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe3, 0x80, 0x81, 0x31, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94,
+	0xe4, 0xb8, 0xad, 0xe3, 0x80, 0x81, 0x32, 0xe5, 0xb7, 0xb2, 0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4,
+	0xe3, 0x80, 0x81, 0x33, 0xe5, 0xb7, 0xb2, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7f, 0x0a, 0x13, 0x4e, 0x65, 0x78, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x06,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x2f, 0x92,
+	0x41, 0x2c, 0x2a, 0x2a, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae,
+	0xe7, 0x9a, 0x84, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xef, 0xbc, 0x8c, 0xe9, 0xa6, 0x96, 0xe6,
+	0xac, 0xa1, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8, 0x8d, 0xe4, 0xbc, 0xa0, 0x52, 0x06,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x4e, 0x65, 0x78, 0x74, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x26, 0x92, 0x41,
+	0x23, 0x2a, 0x21, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa2, 0x98, 0xef, 0xbc, 0x8c, 0xe4,
+	0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45,
+	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x24, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xbd, 0x9c,
+	0xe7, 0xad, 0x94, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xef, 0xbc, 0x8c, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9d, 0x9f, 0xe5, 0x90, 0x8e, 0xe4, 0xba, 0xa4, 0xe5, 0x8d, 0xb7, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92,
+	0x41, 0x11, 0x2a, 0x0f, 0xe5, 0xb7, 0xb2, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe9, 0xa2, 0x98,
+	0xe6, 0x95, 0xb0, 0x52, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x2a, 0x08, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0x69, 0x64, 0xd2, 0x01,
+	0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x0a, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x64, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x37, 0x2a, 0x35,
+	0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0x69,
+	0x64, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba,
+	0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0x88, 0x86, 0xe9, 0x83, 0xa8, 0xe5, 0xb7, 0xb2, 0xe5,
+	0xae, 0x8c, 0xe6, 0x88, 0x90, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x1a, 0x45, 0x78, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe7, 0xad,
+	0x94, 0xe6, 0xa1, 0x88, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x52, 0x0b, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x41, 0x6e, 0x64, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
 	0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06, 0xe7,
 	0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x3b, 0x0a, 0x0e, 0x45, 0x78, 0x61, 0x6d,
-	0x69, 0x6e, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x78,
-	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x4e, 0x6f, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x10, 0x01, 0x2a, 0x35, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x4b, 0x6e, 0x6f, 0x77,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x78, 0x61, 0x6d, 0x10, 0x02, 0x2a, 0x63, 0x0a, 0x0b,
-	0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0b, 0x0a, 0x07, 0x4e,
-	0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6e, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0x05, 0x2a, 0x3e, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68,
-	0x6f, 0x69, 0x63, 0x65, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x10,
-	0x02, 0x42, 0x14, 0x5a, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x22, 0xc7, 0x02, 0x0a, 0x18, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x41, 0x6e, 0x64, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe8, 0x80,
+	0x83, 0xe8, 0xaf, 0x95, 0xe6, 0x80, 0xbb, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a,
+	0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x15, 0xe8, 0x80, 0x83, 0xe8, 0xaf,
+	0x95, 0xe5, 0xb7, 0xb2, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x35, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x12, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5,
+	0x89, 0xa9, 0xe4, 0xbd, 0x99, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x2a, 0x12, 0xe5, 0xb7, 0xb2, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe9, 0xa2,
+	0x98, 0xe7, 0x9b, 0xae, 0xe6, 0x95, 0xb0, 0x52, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd3, 0x05, 0x0a,
+	0x12, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9, 0x52, 0x1a,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x0d, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x2a, 0x2d, 0xe6, 0x9c, 0xac, 0xe9, 0xa2, 0x98, 0xe7, 0xb4,
+	0xaf, 0xe8, 0xae, 0xa1, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6,
+	0xef, 0xbc, 0x88, 0xe6, 0xaf, 0xab, 0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x8c, 0xe5, 0x8f, 0xaf, 0xe9,
+	0x80, 0x89, 0xef, 0xbc, 0x89, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a,
+	0x2a, 0xe6, 0x9c, 0xac, 0xe9, 0xa2, 0x98, 0xe7, 0xb4, 0xaf, 0xe8, 0xae, 0xa1, 0xe4, 0xbf, 0xae,
+	0xe6, 0x94, 0xb9, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0xef,
+	0xbc, 0x88, 0xe5, 0x8f, 0xaf, 0xe9, 0x80, 0x89, 0xef, 0xbc, 0x89, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x1a, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x41,
+	0x92, 0x41, 0x3e, 0x2a, 0x3c, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe9, 0xa2, 0x98, 0xe7, 0xad,
+	0x94, 0xe6, 0xa1, 0x88, 0xef, 0xbc, 0x9a, 0xe6, 0x8c, 0x89, 0xe5, 0x90, 0x8d, 0xe6, 0xac, 0xa1,
+	0xe4, 0xbb, 0x8e, 0xe9, 0xab, 0x98, 0xe5, 0x88, 0xb0, 0xe4, 0xbd, 0x8e, 0xe6, 0x8e, 0x92, 0xe5,
+	0x88, 0x97, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe9, 0x80, 0x89, 0xe9, 0xa1,
+	0xb9, 0x52, 0x1a, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x59, 0x0a,
+	0x12, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x24,
+	0xe8, 0xbf, 0xab, 0xe9, 0x80, 0x89, 0xe9, 0xa2, 0x98, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xef,
+	0xbc, 0x9a, 0xe6, 0x9c, 0x80, 0xe7, 0xac, 0xa6, 0xe5, 0x90, 0x88, 0xe7, 0x9a, 0x84, 0xe9, 0x80,
+	0x89, 0xe9, 0xa1, 0xb9, 0x52, 0x12, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x5e, 0x0a, 0x13, 0x6c, 0x65, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a, 0x27, 0xe8, 0xbf, 0xab, 0xe9,
+	0x80, 0x89, 0xe9, 0xa2, 0x98, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xef, 0xbc, 0x9a, 0xe6, 0x9c,
+	0x80, 0xe4, 0xb8, 0x8d, 0xe7, 0xac, 0xa6, 0xe5, 0x90, 0x88, 0xe7, 0x9a, 0x84, 0xe9, 0x80, 0x89,
+	0xe9, 0xa1, 0xb9, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92,
+	0x41, 0x23, 0x2a, 0x21, 0xe5, 0xa1, 0xab, 0xe7, 0xa9, 0xba, 0xe3, 0x80, 0x81, 0xe6, 0x95, 0xb0,
+	0xe5, 0x80, 0xbc, 0xe3, 0x80, 0x81, 0xe9, 0x97, 0xae, 0xe7, 0xad, 0x94, 0xe9, 0xa2, 0x98, 0xe7,
+	0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x22, 0x63, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0b, 0x92,
+	0x41, 0x08, 0x2a, 0x06, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x0b, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a,
+	0x17, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x2a, 0x0c, 0xe4, 0xba, 0x8b,
+	0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xd2, 0x01, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xfb, 0x02, 0x0a, 0x0f, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x6c,
+	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x4c, 0x92, 0x41, 0x49, 0x2a, 0x47, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x61, 0x62, 0x2c, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x2c, 0x20, 0x70, 0x61, 0x73, 0x74, 0x65, 0x2c,
+	0x20, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x2c, 0x20, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x51, 0x0a, 0x0b,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a, 0x2a, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab,
+	0xaf, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x88,
+	0xe6, 0xaf, 0xab, 0xe7, 0xa7, 0x92, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0xef,
+	0xbc, 0x89, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x6e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x2a, 0x2d, 0xe4, 0xba, 0x8b, 0xe4,
+	0xbb, 0xb6, 0xe9, 0x99, 0x84, 0xe5, 0x8a, 0xa0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0xef, 0xbc,
+	0x8c, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0x9a, 0x32, 0x30, 0xe9, 0xa1, 0xb9, 0xe3, 0x80, 0x81, 0x32,
+	0x30, 0x34, 0x38, 0xe5, 0xad, 0x97, 0xe8, 0x8a, 0x82, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a,
+	0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x2a, 0x12, 0xe5, 0xb7, 0xb2,
+	0xe6, 0x8e, 0xa5, 0xe6, 0x94, 0xb6, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x95, 0xb0, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x69, 0x0a, 0x19, 0x45, 0x78, 0x61,
+	0x6d, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24,
+	0x92, 0x41, 0x21, 0x2a, 0x0e, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x85, 0xb3, 0xe8, 0x81,
+	0x94, 0x49, 0x44, 0xd2, 0x01, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x45, 0x78, 0x61, 0x6d, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x38, 0x92, 0x41, 0x35, 0x2a, 0x33, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88,
+	0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xef, 0xbc, 0x8c, 0xe6,
+	0x8c, 0x89, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0xab, 0xaf, 0xe6, 0x8e, 0xa5, 0xe6, 0x94,
+	0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x88, 0x06, 0x0a, 0x0e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0b, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12,
+	0x36, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x24, 0x92, 0x41,
+	0x21, 0x2a, 0x1f, 0xe6, 0x9c, 0xac, 0xe9, 0xa2, 0x98, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5,
+	0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x8e, 0x31, 0xe5, 0xbc, 0x80, 0xe5,
+	0xa7, 0x8b, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x78, 0x0a, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35,
+	0x2a, 0x33, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9, 0xef, 0xbc,
+	0x88, 0xe5, 0x8e, 0x9f, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xaf, 0x8d,
+	0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8e, 0xe7, 0xae, 0x97, 0xe5, 0x88, 0x86, 0xe4, 0xb8, 0x80, 0xe8,
+	0x87, 0xb4, 0xef, 0xbc, 0x89, 0x52, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x4f, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x24, 0xe6,
+	0x9c, 0xac, 0xe9, 0xa2, 0x98, 0xe7, 0xb4, 0xaf, 0xe8, 0xae, 0xa1, 0xe4, 0xbd, 0x9c, 0xe7, 0xad,
+	0x94, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xef, 0xbc, 0x88, 0xe6, 0xaf, 0xab, 0xe7, 0xa7, 0x92,
+	0xef, 0xbc, 0x89, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f,
+	0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x23, 0x92, 0x41, 0x20, 0x2a, 0x1e, 0xe6,
+	0x9c, 0xac, 0xe9, 0xa2, 0x98, 0xe7, 0xb4, 0xaf, 0xe8, 0xae, 0xa1, 0xe4, 0xbf, 0xae, 0xe6, 0x94,
+	0xb9, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x13, 0x92, 0x41, 0x10, 0x2a, 0x0e, 0xe4, 0xbd, 0x9c, 0xe7, 0xad, 0x94, 0xe4, 0xbc, 0x9a, 0xe8,
+	0xaf, 0x9d, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x12, 0x51, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a, 0x2a, 0xe6, 0x9c, 0x8d, 0xe5,
+	0x8a, 0xa1, 0xe7, 0xab, 0xaf, 0xe6, 0x8e, 0xa5, 0xe6, 0x94, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0xef, 0xbc, 0x88, 0xe6, 0xaf, 0xab, 0xe7, 0xa7, 0x92, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0xe6, 0x88, 0xb3, 0xef, 0xbc, 0x89, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x2a, 0x21, 0xe5,
+	0xa1, 0xab, 0xe7, 0xa9, 0xba, 0xe3, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe5, 0x80, 0xbc, 0xe3, 0x80,
+	0x81, 0xe9, 0x97, 0xae, 0xe7, 0xad, 0x94, 0xe9, 0xa2, 0x98, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88,
+	0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0xa3, 0x01,
+	0x0a, 0x22, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x53, 0x92, 0x41, 0x50, 0x2a,
+	0x4e, 0xe8, 0x80, 0x83, 0xe7, 0x94, 0x9f, 0xe7, 0x9c, 0x8b, 0xe5, 0x88, 0xb0, 0xe7, 0x9a, 0x84,
+	0xe9, 0x80, 0x89, 0xe9, 0xa1, 0xb9, 0xe5, 0xad, 0x97, 0xe6, 0xaf, 0x8d, 0xef, 0xbc, 0x8c, 0xe8,
+	0xaf, 0x95, 0xe5, 0x8d, 0xb7, 0xe6, 0x9c, 0xaa, 0xe6, 0x89, 0x93, 0xe4, 0xb9, 0xb1, 0xe9, 0x80,
+	0x89, 0xe9, 0xa1, 0xb9, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8e, 0xe5, 0x8e, 0x9f, 0xe9, 0xa1, 0xba,
+	0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xaf, 0x8d, 0xe7, 0x9b, 0xb8, 0xe5, 0x90, 0x8c, 0x52,
+	0x22, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x2a, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x3a, 0x01,
+	0x31, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x12, 0x92, 0x41, 0x0f, 0x2a, 0x09, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0,
+	0x3a, 0x02, 0x31, 0x30, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe5, 0xbe, 0x85, 0xe8, 0xaf,
+	0x84, 0xe5, 0x88, 0x86, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0xbe, 0x02, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x2a, 0x08, 0xe7, 0xad,
+	0x94, 0xe6, 0xa1, 0x88, 0x49, 0x44, 0x52, 0x09, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x12, 0x3d, 0x0a, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x2a, 0x08, 0xe7, 0xad, 0x94, 0xe5, 0x8d, 0xb7, 0x49, 0x44, 0x52, 0x12, 0x65, 0x78,
+	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x32, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x2a, 0x0b, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae,
+	0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x98, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x31, 0x0a,
+	0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe5, 0x88,
+	0x86, 0xe5, 0x80, 0xbc, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x33, 0x0a, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe8, 0x80, 0x83, 0xe7,
+	0x94, 0x9f, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x19, 0x92, 0x41, 0x16, 0x2a, 0x08, 0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x49, 0x44, 0xd2, 0x01,
+	0x09, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x52, 0x09, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x24, 0x92, 0x41, 0x21, 0x2a, 0x1f, 0xe5, 0xbe, 0x97, 0xe5, 0x88,
+	0x86, 0xef, 0xbc, 0x8c, 0x30, 0xe5, 0x88, 0xb0, 0xe9, 0xa2, 0x98, 0xe7, 0x9b, 0xae, 0xe5, 0x88,
+	0x86, 0xe5, 0x80, 0xbc, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x58, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x23, 0x92, 0x41, 0x20,
+	0x2a, 0x1e, 0xe8, 0xaf, 0xa5, 0xe7, 0xad, 0x94, 0xe5, 0x8d, 0xb7, 0xe5, 0x89, 0xa9, 0xe4, 0xbd,
+	0x99, 0xe5, 0xbe, 0x85, 0xe8, 0xaf, 0x84, 0xe5, 0x88, 0x86, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+	0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41, 0x21,
+	0x2a, 0x0e, 0xe8, 0x80, 0x83, 0xe8, 0xaf, 0x95, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0x49, 0x44,
+	0xd2, 0x01, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x22, 0x8e, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x2f,
+	0x92, 0x41, 0x2c, 0x2a, 0x2a, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81, 0xe8, 0x8c, 0x83, 0xe5, 0x9b,
+	0xb4, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81, 0xe6, 0x97, 0xb6,
+	0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x88, 0x90, 0xe7, 0xbb, 0xa9, 0x52,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x10, 0x73,
+	0x61, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe8, 0xaf, 0x95, 0xe5,
+	0x8d, 0xb7, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x10, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f,
+	0x70, 0x61, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x2a, 0x06,
+	0xe6, 0x80, 0xbb, 0xe5, 0x88, 0x86, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x52, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38,
+	0x92, 0x41, 0x35, 0x2a, 0x33, 0xe6, 0x80, 0xbb, 0xe5, 0x88, 0x86, 0xe6, 0x89, 0x80, 0xe5, 0x9c,
+	0xa8, 0xe5, 0x8c, 0xba, 0xe9, 0x97, 0xb4, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xaf, 0xad,
+	0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe6, 0x8a, 0xa5, 0xe5,
+	0x91, 0x8a, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4f, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe7, 0xbb, 0xb4, 0xe5, 0xba, 0xa6,
+	0xe5, 0xbe, 0x97, 0xe5, 0x88, 0x86, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x0f, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x2a, 0x08, 0xe7, 0xbb, 0xb4, 0xe5, 0xba, 0xa6, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x2a, 0x0c, 0xe7, 0xbb,
+	0xb4, 0xe5, 0xba, 0xa6, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x14, 0x92, 0x41, 0x11, 0x2a, 0x0f, 0xe7,
+	0xbb, 0xb4, 0xe5, 0xba, 0xa6, 0xe6, 0xa0, 0x87, 0xe5, 0x87, 0x86, 0xe5, 0x88, 0x86, 0x52, 0x0e,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x5b,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x41, 0x92, 0x41, 0x3e, 0x2a, 0x3c, 0xe7, 0xbb, 0xb4, 0xe5, 0xba, 0xa6, 0xe6, 0xa0, 0x87, 0xe5,
+	0x87, 0x86, 0xe5, 0x88, 0x86, 0xe6, 0x89, 0x80, 0xe5, 0x9c, 0xa8, 0xe5, 0x8c, 0xba, 0xe9, 0x97,
+	0xb4, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xaf, 0xad, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85,
+	0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe6, 0x8a, 0xa5, 0xe5, 0x91, 0x8a, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2a, 0x3b, 0x0a, 0x0e, 0x45,
+	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a,
+	0x11, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x4e, 0x6f, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x01, 0x2a, 0x35, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x4b,
+	0x6e, 0x6f, 0x77, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x78, 0x61, 0x6d, 0x10, 0x02, 0x2a,
+	0x78, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0b,
+	0x0a, 0x07, 0x4e, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x2a, 0x8d, 0x01, 0x0a, 0x0c, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x61,
+	0x64, 0x69, 0x6f, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x69,
+	0x6b, 0x65, 0x72, 0x74, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x10,
+	0x06, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x10, 0x07, 0x12, 0x09,
+	0x0a, 0x05, 0x45, 0x73, 0x73, 0x61, 0x79, 0x10, 0x08, 0x2a, 0x3e, 0x0a, 0x09, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x10, 0x02, 0x12, 0x09,
+	0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x10, 0x03, 0x2a, 0x67, 0x0a, 0x0d, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x10, 0x03, 0x2a, 0x49, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x0e, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x10, 0x02, 0x42, 0x14, 0x5a,
+	0x12, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1389,45 +3807,91 @@ func file_exam_api_v1_exam_modes_proto_rawDescGZIP() []byte {
 	return file_exam_api_v1_exam_modes_proto_rawDescData
 }
 
-var file_exam_api_v1_exam_modes_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_exam_api_v1_exam_modes_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_exam_api_v1_exam_modes_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_exam_api_v1_exam_modes_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_exam_api_v1_exam_modes_proto_goTypes = []interface{}{
 	(ExamineeStatus)(0),                // 0: exam_api.v1.ExamineeStatus
 	(LoginPlatform)(0),                 // 1: exam_api.v1.LoginPlatform
 	(StageNumber)(0),                   // 2: exam_api.v1.StageNumber
 	(QuestionType)(0),                  // 3: exam_api.v1.QuestionType
-	(*ExamLoginRequest)(nil),           // 4: exam_api.v1.ExamLoginRequest
-	(*ExamLoginResponse)(nil),          // 5: exam_api.v1.ExamLoginResponse
-	(*GetExamPageListRequest)(nil),     // 6: exam_api.v1.GetExamPageListRequest
-	(*GetExamPageListResponse)(nil),    // 7: exam_api.v1.GetExamPageListResponse
-	(*ExamData)(nil),                   // 8: exam_api.v1.ExamData
-	(*StartExamRequest)(nil),           // 9: exam_api.v1.StartExamRequest
-	(*StartExamResponse)(nil),          // 10: exam_api.v1.StartExamResponse
-	(*QuestionData)(nil),               // 11: exam_api.v1.QuestionData
-	(*QuestionOptionData)(nil),         // 12: exam_api.v1.QuestionOptionData
-	(*ExamQuestionRequest)(nil),        // 13: exam_api.v1.ExamQuestionRequest
-	(*ExamQuestionResponse)(nil),       // 14: exam_api.v1.ExamQuestionResponse
-	(*ExamQuestionRecordRequest)(nil),  // 15: exam_api.v1.ExamQuestionRecordRequest
-	(*ExamQuestionRecordResponse)(nil), // 16: exam_api.v1.ExamQuestionRecordResponse
-	(*HeartbeatAndSaveRequest)(nil),    // 17: exam_api.v1.HeartbeatAndSaveRequest
-	(*HeartbeatAndSaveResponse)(nil),   // 18: exam_api.v1.HeartbeatAndSaveResponse
-	(*QuestionAnswerData)(nil),         // 19: exam_api.v1.QuestionAnswerData
-	(*SubmitExamRequest)(nil),          // 20: exam_api.v1.SubmitExamRequest
-	(*SubmitExamResponse)(nil),         // 21: exam_api.v1.SubmitExamResponse
+	(MediaType)(0),                     // 4: exam_api.v1.MediaType
+	(SectionStatus)(0),                 // 5: exam_api.v1.SectionStatus
+	(ResultVisibility)(0),              // 6: exam_api.v1.ResultVisibility
+	(*ExamLoginRequest)(nil),           // 7: exam_api.v1.ExamLoginRequest
+	(*ExamLoginResponse)(nil),          // 8: exam_api.v1.ExamLoginResponse
+	(*RequestLoginCodeRequest)(nil),    // 9: exam_api.v1.RequestLoginCodeRequest
+	(*RequestLoginCodeResponse)(nil),   // 10: exam_api.v1.RequestLoginCodeResponse
+	(*VerifyLoginCodeRequest)(nil),     // 11: exam_api.v1.VerifyLoginCodeRequest
+	(*VerifyLoginCodeResponse)(nil),    // 12: exam_api.v1.VerifyLoginCodeResponse
+	(*RefreshTokenRequest)(nil),        // 13: exam_api.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),       // 14: exam_api.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),              // 15: exam_api.v1.LogoutRequest
+	(*LogoutResponse)(nil),             // 16: exam_api.v1.LogoutResponse
+	(*GetExamPageListRequest)(nil),     // 17: exam_api.v1.GetExamPageListRequest
+	(*GetExamPageListResponse)(nil),    // 18: exam_api.v1.GetExamPageListResponse
+	(*ExamData)(nil),                   // 19: exam_api.v1.ExamData
+	(*StartExamRequest)(nil),           // 20: exam_api.v1.StartExamRequest
+	(*StartExamResponse)(nil),          // 21: exam_api.v1.StartExamResponse
+	(*QuestionData)(nil),               // 22: exam_api.v1.QuestionData
+	(*QuestionOptionData)(nil),         // 23: exam_api.v1.QuestionOptionData
+	(*Attachment)(nil),                 // 24: exam_api.v1.Attachment
+	(*ExamQuestionRequest)(nil),        // 25: exam_api.v1.ExamQuestionRequest
+	(*ExamQuestionResponse)(nil),       // 26: exam_api.v1.ExamQuestionResponse
+	(*SectionData)(nil),                // 27: exam_api.v1.SectionData
+	(*NextQuestionRequest)(nil),        // 28: exam_api.v1.NextQuestionRequest
+	(*NextQuestionResponse)(nil),       // 29: exam_api.v1.NextQuestionResponse
+	(*SubmitSectionRequest)(nil),       // 30: exam_api.v1.SubmitSectionRequest
+	(*SubmitSectionResponse)(nil),      // 31: exam_api.v1.SubmitSectionResponse
+	(*ExamQuestionRecordRequest)(nil),  // 32: exam_api.v1.ExamQuestionRecordRequest
+	(*ExamQuestionRecordResponse)(nil), // 33: exam_api.v1.ExamQuestionRecordResponse
+	(*HeartbeatAndSaveRequest)(nil),    // 34: exam_api.v1.HeartbeatAndSaveRequest
+	(*HeartbeatAndSaveResponse)(nil),   // 35: exam_api.v1.HeartbeatAndSaveResponse
+	(*QuestionAnswerData)(nil),         // 36: exam_api.v1.QuestionAnswerData
+	(*SubmitExamRequest)(nil),          // 37: exam_api.v1.SubmitExamRequest
+	(*SubmitExamResponse)(nil),         // 38: exam_api.v1.SubmitExamResponse
+	(*ReportExamEventsRequest)(nil),    // 39: exam_api.v1.ReportExamEventsRequest
+	(*ClientExamEvent)(nil),            // 40: exam_api.v1.ClientExamEvent
+	(*ReportExamEventsResponse)(nil),   // 41: exam_api.v1.ReportExamEventsResponse
+	(*ExamAnswerTimelineRequest)(nil),  // 42: exam_api.v1.ExamAnswerTimelineRequest
+	(*ExamAnswerTimelineResponse)(nil), // 43: exam_api.v1.ExamAnswerTimelineResponse
+	(*AnswerRevision)(nil),             // 44: exam_api.v1.AnswerRevision
+	(*GetGradingQueueRequest)(nil),     // 45: exam_api.v1.GetGradingQueueRequest
+	(*GetGradingQueueResponse)(nil),    // 46: exam_api.v1.GetGradingQueueResponse
+	(*GradingItem)(nil),                // 47: exam_api.v1.GradingItem
+	(*GradeAnswerRequest)(nil),         // 48: exam_api.v1.GradeAnswerRequest
+	(*GradeAnswerResponse)(nil),        // 49: exam_api.v1.GradeAnswerResponse
+	(*GetExamResultRequest)(nil),       // 50: exam_api.v1.GetExamResultRequest
+	(*GetExamResultResponse)(nil),      // 51: exam_api.v1.GetExamResultResponse
+	(*DimensionResult)(nil),            // 52: exam_api.v1.DimensionResult
+	nil,                                // 53: exam_api.v1.ClientExamEvent.MetaEntry
 }
 var file_exam_api_v1_exam_modes_proto_depIdxs = []int32{
-	8,  // 0: exam_api.v1.GetExamPageListResponse.exam_list:type_name -> exam_api.v1.ExamData
+	19, // 0: exam_api.v1.GetExamPageListResponse.exam_list:type_name -> exam_api.v1.ExamData
 	3,  // 1: exam_api.v1.QuestionData.question_type_id:type_name -> exam_api.v1.QuestionType
-	12, // 2: exam_api.v1.QuestionData.question_options_data:type_name -> exam_api.v1.QuestionOptionData
-	11, // 3: exam_api.v1.ExamQuestionResponse.question_data:type_name -> exam_api.v1.QuestionData
-	19, // 4: exam_api.v1.ExamQuestionRecordResponse.answer_data:type_name -> exam_api.v1.QuestionAnswerData
-	19, // 5: exam_api.v1.HeartbeatAndSaveRequest.answer_data:type_name -> exam_api.v1.QuestionAnswerData
-	19, // 6: exam_api.v1.SubmitExamRequest.answer_data:type_name -> exam_api.v1.QuestionAnswerData
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	23, // 2: exam_api.v1.QuestionData.question_options_data:type_name -> exam_api.v1.QuestionOptionData
+	24, // 3: exam_api.v1.QuestionData.attachments:type_name -> exam_api.v1.Attachment
+	24, // 4: exam_api.v1.QuestionOptionData.attachments:type_name -> exam_api.v1.Attachment
+	4,  // 5: exam_api.v1.Attachment.media_type:type_name -> exam_api.v1.MediaType
+	22, // 6: exam_api.v1.ExamQuestionResponse.question_data:type_name -> exam_api.v1.QuestionData
+	27, // 7: exam_api.v1.ExamQuestionResponse.section:type_name -> exam_api.v1.SectionData
+	27, // 8: exam_api.v1.ExamQuestionResponse.sections:type_name -> exam_api.v1.SectionData
+	5,  // 9: exam_api.v1.SectionData.status:type_name -> exam_api.v1.SectionStatus
+	36, // 10: exam_api.v1.NextQuestionRequest.answer:type_name -> exam_api.v1.QuestionAnswerData
+	22, // 11: exam_api.v1.NextQuestionResponse.question:type_name -> exam_api.v1.QuestionData
+	36, // 12: exam_api.v1.ExamQuestionRecordResponse.answer_data:type_name -> exam_api.v1.QuestionAnswerData
+	36, // 13: exam_api.v1.HeartbeatAndSaveRequest.answer_data:type_name -> exam_api.v1.QuestionAnswerData
+	36, // 14: exam_api.v1.SubmitExamRequest.answer_data:type_name -> exam_api.v1.QuestionAnswerData
+	40, // 15: exam_api.v1.ReportExamEventsRequest.events:type_name -> exam_api.v1.ClientExamEvent
+	53, // 16: exam_api.v1.ClientExamEvent.meta:type_name -> exam_api.v1.ClientExamEvent.MetaEntry
+	44, // 17: exam_api.v1.ExamAnswerTimelineResponse.revisions:type_name -> exam_api.v1.AnswerRevision
+	47, // 18: exam_api.v1.GetGradingQueueResponse.items:type_name -> exam_api.v1.GradingItem
+	6,  // 19: exam_api.v1.GetExamResultResponse.visibility:type_name -> exam_api.v1.ResultVisibility
+	52, // 20: exam_api.v1.GetExamResultResponse.dimensions:type_name -> exam_api.v1.DimensionResult
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_exam_api_v1_exam_modes_proto_init() }
@@ -1448,8 +3912,80 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExamLoginResponse); i {
+		file_exam_api_v1_exam_modes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLoginCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLoginCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyLoginCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyLoginCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1460,7 +3996,31 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetExamPageListRequest); i {
 			case 0:
 				return &v.state
@@ -1472,7 +4032,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetExamPageListResponse); i {
 			case 0:
 				return &v.state
@@ -1484,7 +4044,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExamData); i {
 			case 0:
 				return &v.state
@@ -1496,7 +4056,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartExamRequest); i {
 			case 0:
 				return &v.state
@@ -1508,7 +4068,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartExamResponse); i {
 			case 0:
 				return &v.state
@@ -1520,7 +4080,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuestionData); i {
 			case 0:
 				return &v.state
@@ -1532,7 +4092,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuestionOptionData); i {
 			case 0:
 				return &v.state
@@ -1544,7 +4104,19 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExamQuestionRequest); i {
 			case 0:
 				return &v.state
@@ -1556,7 +4128,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExamQuestionResponse); i {
 			case 0:
 				return &v.state
@@ -1568,7 +4140,67 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExamQuestionRecordRequest); i {
 			case 0:
 				return &v.state
@@ -1580,7 +4212,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExamQuestionRecordResponse); i {
 			case 0:
 				return &v.state
@@ -1592,7 +4224,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HeartbeatAndSaveRequest); i {
 			case 0:
 				return &v.state
@@ -1604,7 +4236,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HeartbeatAndSaveResponse); i {
 			case 0:
 				return &v.state
@@ -1616,7 +4248,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuestionAnswerData); i {
 			case 0:
 				return &v.state
@@ -1628,7 +4260,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitExamRequest); i {
 			case 0:
 				return &v.state
@@ -1640,7 +4272,7 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
-		file_exam_api_v1_exam_modes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_exam_api_v1_exam_modes_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitExamResponse); i {
 			case 0:
 				return &v.state
@@ -1652,14 +4284,182 @@ func file_exam_api_v1_exam_modes_proto_init() {
 				return nil
 			}
 		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportExamEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientExamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportExamEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamAnswerTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamAnswerTimelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradingQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradingQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeAnswerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeAnswerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExamResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExamResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_api_v1_exam_modes_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DimensionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exam_api_v1_exam_modes_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   18,
+			NumEnums:      7,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
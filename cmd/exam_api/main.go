@@ -1,9 +1,11 @@
 package main
 
 import (
+	"exam_api/api/common"
 	"exam_api/internal/middleware"
 	"exam_api/internal/pkg/ijwt"
 	"exam_api/internal/pkg/isnowflake"
+	"exam_api/internal/server"
 	"flag"
 	"github.com/airunny/wiki-go-tools/ilog"
 	"os"
@@ -37,7 +39,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ss *server.SweeperServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -47,6 +49,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			ss,
 		),
 	)
 }
@@ -68,20 +71,37 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	// 服务统一配置（对象存储 domain 等）与 server 使用同一节点
+	var sc common.ServerConfig
+	if err := c.Value("server").Scan(&sc); err != nil {
+		panic(err)
+	}
 	logger, closer := ilog.NewLogger(id, Name)
 	defer closer.Close()
 
 	// 初始化jwt
-	middleware.JWT = ijwt.NewSecureJWT(bc.Data.Jwt.AccessSecret, bc.Data.Jwt.ExamSecret, []ijwt.SecurityOption{
+	jwtOptions := []ijwt.SecurityOption{
 		ijwt.WithAccessExpiry(time.Minute * time.Duration(bc.Data.Jwt.AccessTokenExpireMinutes)),
-	}...)
+	}
+	if bc.Data.Jwt.RefreshSecret != "" {
+		jwtOptions = append(jwtOptions, ijwt.WithRefreshSecret(bc.Data.Jwt.RefreshSecret))
+	}
+	if bc.Data.Jwt.RefreshTokenExpireMinutes > 0 {
+		jwtOptions = append(jwtOptions, ijwt.WithRefreshExpiry(time.Minute*time.Duration(bc.Data.Jwt.RefreshTokenExpireMinutes)))
+	}
+	fingerprintPolicy, err := ijwt.ParseFingerprintPolicy(bc.Data.Jwt.FingerprintPolicy)
+	if err != nil {
+		panic(err)
+	}
+	jwtOptions = append(jwtOptions, ijwt.WithFingerprintPolicy(fingerprintPolicy))
+	middleware.JWT = ijwt.NewSecureJWT(bc.Data.Jwt.AccessSecret, bc.Data.Jwt.ExamSecret, jwtOptions...)
 	// 初始化雪花算法
 	snowFlake, err := isnowflake.NewSnowflake(1)
 	if err != nil {
 		panic(err)
 	}
 	isnowflake.SnowFlake = snowFlake
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, &sc, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -6,6 +6,7 @@
 package main
 
 import (
+	"exam_api/api/common"
 	"exam_api/internal/biz"
 	"exam_api/internal/conf"
 	"exam_api/internal/data"
@@ -18,6 +19,6 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *common.ServerConfig, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
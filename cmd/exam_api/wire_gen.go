@@ -7,6 +7,7 @@
 package main
 
 import (
+	"exam_api/api/common"
 	"exam_api/internal/biz"
 	"exam_api/internal/conf"
 	"exam_api/internal/data"
@@ -23,31 +24,44 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, serverConfig *common.ServerConfig, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	examineeRepo := data.NewExamineeRepo(dataData, logger)
 	sysLoginRepo := data.NewSysLoginRepo(dataData, logger)
-	loginUseCase := biz.NewLoginUseCase(examineeRepo, sysLoginRepo, logger)
+	emailRepo := data.NewEmailRepo(confData, logger)
+	redisRepository := data.RedisRepositoryFromData(dataData)
+	loginUseCase := biz.NewLoginUseCase(examineeRepo, sysLoginRepo, emailRepo, redisRepository, logger)
 	examineeSalesPaperAssociationRepo := data.NewExamineeSalesPaperAssociationRepo(dataData, logger)
 	salesPaperRepo := data.NewSalesPaperRepo(dataData, logger)
 	salesPaperUseCase := biz.NewSalesPaperUseCase(salesPaperRepo, logger)
+	examineeSalesPaperAssociationUseCase := biz.NewExamineeSalesPaperAssociationUseCase(examineeSalesPaperAssociationRepo, salesPaperUseCase, logger)
 	questionRepo := data.NewQuestionRepo(dataData, logger)
-	redisRepository := data.RedisRepositoryFromData(dataData)
 	questionUseCase := biz.NewQuestionUseCase(questionRepo, redisRepository, logger)
-	examineeSalesPaperAssociationUseCase := biz.NewExamineeSalesPaperAssociationUseCase(examineeSalesPaperAssociationRepo, salesPaperUseCase, questionUseCase, logger)
 	examineeAnswerRepo := data.NewExamineeAnswerRepo(dataData, logger)
 	examineeQuestionAnswerRepo := data.NewExamineeQuestionAnswerRepo(dataData, logger)
 	examineeQuestionAnswerUseCase := biz.NewExamineeQuestionAnswerUseCase(examineeQuestionAnswerRepo, logger)
 	examEventRepo := data.NewExamEventRepo(dataData, logger)
 	examEventUseCase := biz.NewExamEventUseCase(examEventRepo, logger)
-	examineeAnswerUseCase := biz.NewExamineeAnswerUseCase(examineeAnswerRepo, examineeSalesPaperAssociationUseCase, salesPaperUseCase, examineeQuestionAnswerUseCase, examEventUseCase, redisRepository, logger)
-	examService := service.NewExamService(loginUseCase, examineeSalesPaperAssociationUseCase, questionUseCase, salesPaperUseCase, examineeAnswerUseCase)
-	grpcServer := server.NewGRPCServer(confServer, examService, logger)
-	httpServer := server.NewHTTPServer(confServer, examService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	examineeAnswerDimensionScoreRepo := data.NewExamineeAnswerDimensionScoreRepo(dataData, logger)
+	examineeAnswerScoreUseCase := biz.NewExamineeAnswerScoreUseCase(examineeAnswerDimensionScoreRepo, examineeAnswerRepo, examineeSalesPaperAssociationUseCase, salesPaperUseCase, questionUseCase, examineeQuestionAnswerUseCase, examEventUseCase, confData, logger)
+	storageRepo, err := data.NewStorageRepo(serverConfig, confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	examineeAnswerUseCase := biz.NewExamineeAnswerUseCase(examineeAnswerRepo, examineeSalesPaperAssociationUseCase, salesPaperUseCase, examineeQuestionAnswerUseCase, examEventUseCase, examineeAnswerScoreUseCase, questionUseCase, redisRepository, storageRepo, logger)
+	examService := service.NewExamService(loginUseCase, examineeSalesPaperAssociationUseCase, questionUseCase, salesPaperUseCase, examineeAnswerUseCase, examineeAnswerScoreUseCase)
+	grpcServer := server.NewGRPCServer(confServer, examService, loginUseCase, logger)
+	httpServer, err := server.NewHTTPServer(confServer, examService, loginUseCase, examineeAnswerUseCase, storageRepo, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	sweeperServer := server.NewSweeperServer(examineeAnswerUseCase, logger)
+	app := newApp(logger, grpcServer, httpServer, sweeperServer)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -3,6 +3,7 @@ module exam_api
 go 1.23.8
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/airunny/copier v0.0.0-20230213055356-e2bee624c0ab
 	github.com/airunny/wiki-go-tools v1.0.1
 	github.com/expr-lang/expr v1.17.5
@@ -11,4 +11,5 @@ var ProviderSet = wire.NewSet(
 	NewQuestionUseCase,
 	NewExamineeAnswerUseCase,
 	NewExamineeQuestionAnswerUseCase,
-	NewExamEventUseCase)
+	NewExamEventUseCase,
+	NewExamineeAnswerScoreUseCase)
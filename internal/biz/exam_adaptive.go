@@ -0,0 +1,341 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/data/entity"
+	"exam_api/internal/pkg/icontext"
+	innErr "exam_api/internal/pkg/ierrors"
+	"fmt"
+	"math"
+)
+
+// 自适应试卷中已计入能力估计的题目不能再修改答案，原样重复提交的答案直接忽略
+const AnswerAdaptiveAnswered = "ADAPTIVE_ANSWERED"
+
+// AdaptiveConfig 自适应出题的终止规则，试卷通过 SalesPaper.AdaptiveConfig 覆盖，未配置的项使用默认值。
+// 每个维度在标准误不高于阈值或作答题数达到上限时结束，题库用完也结束
+type AdaptiveConfig struct {
+	SEThreshold float64 `json:"se_threshold"` // 能力估计标准误阈值
+	MaxItems    int     `json:"max_items"`    // 每个维度最多作答题数
+}
+
+var defaultAdaptiveConfig = AdaptiveConfig{
+	SEThreshold: 0.3,
+	MaxItems:    20,
+}
+
+// AdaptiveItem 一道已作答的题目
+type AdaptiveItem struct {
+	QuestionID string `json:"question_id"` // 题目id
+	Correct    bool   `json:"correct"`     // 是否答对
+}
+
+// AdaptiveDimension 一个维度的作答记录和能力估计
+type AdaptiveDimension struct {
+	DimensionID string          `json:"dimension_id"` // 维度id
+	Theta       float64         `json:"theta"`        // 能力估计值
+	SE          float64         `json:"se"`           // 能力估计标准误
+	Items       []*AdaptiveItem `json:"items"`        // 已作答的题目（按作答顺序）
+	Done        bool            `json:"done"`         // 是否已满足终止规则
+}
+
+// AdaptiveState 一次作答的自适应状态，保存在 ExamineeAnswer.AdaptiveState
+type AdaptiveState struct {
+	Dimensions []*AdaptiveDimension `json:"dimensions"` // 按作答顺序
+	Pending    string               `json:"pending"`    // 已下发、待作答的题目id
+}
+
+// ParseAdaptiveConfig 解析试卷配置的终止规则，未配置或配置无效时使用默认值
+func ParseAdaptiveConfig(config string) (AdaptiveConfig, error) {
+	res := defaultAdaptiveConfig
+	if config == "" {
+		return res, nil
+	}
+	if err := json.Unmarshal([]byte(config), &res); err != nil {
+		return defaultAdaptiveConfig, err
+	}
+	// 题数上限不大于0时维度会在作答前就结束，标准误阈值不大于0时只能靠题数上限结束
+	if res.MaxItems <= 0 || res.SEThreshold <= 0 {
+		return defaultAdaptiveConfig, fmt.Errorf("invalid adaptive config: max_items=%d, se_threshold=%v", res.MaxItems, res.SEThreshold)
+	}
+	return res, nil
+}
+
+func parseAdaptiveState(value string) (*AdaptiveState, error) {
+	state := &AdaptiveState{Dimensions: make([]*AdaptiveDimension, 0)}
+	if value == "" {
+		return state, nil
+	}
+	if err := json.Unmarshal([]byte(value), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// answered 题目是否已计入能力估计
+func (s *AdaptiveState) answered(questionId string) bool {
+	for _, dimension := range s.Dimensions {
+		for _, item := range dimension.Items {
+			if item.QuestionID == questionId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *AdaptiveState) answeredCount() (count int32) {
+	for _, dimension := range s.Dimensions {
+		count += int32(len(dimension.Items))
+	}
+	return
+}
+
+// irtProbability 双参数 logistic 模型下答对的概率
+func irtProbability(question *entity.Question, theta float64) float64 {
+	return 1 / (1 + math.Exp(-question.IrtDiscrimination*(theta-question.IrtDifficulty)))
+}
+
+// irtInformation 题目在 theta 处的信息量
+func irtInformation(question *entity.Question, theta float64) float64 {
+	p := irtProbability(question, theta)
+	return question.IrtDiscrimination * question.IrtDiscrimination * p * (1 - p)
+}
+
+// estimateTheta 以标准正态分布为先验，用期望后验（EAP）估计能力值，返回估计值和标准误。
+// 全对或全错时也能得到有限的估计
+func estimateTheta(items []*AdaptiveItem, mQuestion map[string]*entity.Question) (theta, se float64) {
+	var sum, sumTheta, sumTheta2 float64
+	for point := -4.0; point <= 4.0; point += 0.1 {
+		likelihood := math.Exp(-point * point / 2)
+		for _, item := range items {
+			question, ok := mQuestion[item.QuestionID]
+			if !ok {
+				continue
+			}
+			p := irtProbability(question, point)
+			if item.Correct {
+				likelihood *= p
+			} else {
+				likelihood *= 1 - p
+			}
+		}
+		sum += likelihood
+		sumTheta += likelihood * point
+		sumTheta2 += likelihood * point * point
+	}
+	if sum == 0 {
+		return 0, 1
+	}
+	theta = sumTheta / sum
+	se = math.Sqrt(math.Max(sumTheta2/sum-theta*theta, 0))
+	return
+}
+
+// adaptiveCorrect 判断答案是否答对：客观题所选选项得分之和大于0，填空题和数值题按参考答案评分得分大于0
+func adaptiveCorrect(question *entity.Question, options []*entity.QuestionOption, answer *v1.QuestionAnswerData) bool {
+	if isTextQuestion(v1.QuestionType(question.QuestionTypeID)) {
+		score, _ := autoGrade(question, answer.TextAnswer)
+		return score > 0
+	}
+	score := 0.0
+	for _, letter := range answer.OptionsSerialNumberData {
+		if option := findOptionByLetter(options, letter); option != nil {
+			score += option.Score
+		}
+	}
+	return score > 0
+}
+
+// nextAdaptiveQuestion 按终止规则推进维度，在当前维度未作答的题目中选出当前能力估计处信息量最大的题目。
+// 维度按试卷维度顺序作答，全部维度结束时返回 nil
+func nextAdaptiveQuestion(state *AdaptiveState, config AdaptiveConfig, dimensions []*entity.SalesPaperDimension, questions []*entity.Question, administered map[string]struct{}) *entity.Question {
+	mDimensionQuestions := make(map[string][]*entity.Question)
+	for _, question := range questions {
+		if _, ok := administered[question.ID]; ok {
+			continue
+		}
+		mDimensionQuestions[question.DimensionID] = append(mDimensionQuestions[question.DimensionID], question)
+	}
+	mState := make(map[string]*AdaptiveDimension, len(state.Dimensions))
+	for _, dimension := range state.Dimensions {
+		mState[dimension.DimensionID] = dimension
+	}
+	for _, dimension := range dimensions {
+		cur, ok := mState[dimension.ID]
+		if !ok {
+			cur = &AdaptiveDimension{DimensionID: dimension.ID, SE: 1, Items: make([]*AdaptiveItem, 0)}
+		}
+		if cur.Done {
+			continue
+		}
+		candidates := mDimensionQuestions[dimension.ID]
+		if len(candidates) == 0 || len(cur.Items) >= config.MaxItems || (len(cur.Items) > 0 && cur.SE <= config.SEThreshold) {
+			if ok {
+				cur.Done = true
+			}
+			continue
+		}
+		var (
+			best     *entity.Question
+			bestInfo = -1.0
+		)
+		for _, question := range candidates {
+			if info := irtInformation(question, cur.Theta); info > bestInfo {
+				best, bestInfo = question, info
+			}
+		}
+		if !ok {
+			state.Dimensions = append(state.Dimensions, cur)
+		}
+		return best
+	}
+	return nil
+}
+
+// NextQuestion 自适应出题：保存当前题目的答案并更新所在维度的能力估计，再按能力估计选出下一题。
+// 不传答案时返回已下发、待作答的题目
+func (uc *ExamineeAnswerUseCase) NextQuestion(ctx context.Context, req *v1.NextQuestionRequest) (resp *v1.NextQuestionResponse, err error) {
+	resp = &v1.NextQuestionResponse{}
+	var (
+		l                = uc.log.WithContext(ctx)
+		associationId, _ = icontext.AssociationIdFrom(ctx)
+	)
+	association, err := uc.associationUc.GetById(ctx, associationId)
+	if err != nil {
+		l.Errorf("NextQuestion.associationUc.GetById Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	examineeAnswer, err := uc.repo.GetByAssociationId(ctx, associationId)
+	if err != nil {
+		l.Errorf("NextQuestion.repo.GetByAssociationId Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if association == nil || examineeAnswer == nil {
+		err = errors.New("考试记录不存在")
+		return
+	}
+	if !attemptInProgress(association, examineeAnswer) {
+		err = errors.New("考试状态异常")
+		return
+	}
+	salesPaper, err := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	if !salesPaper.Adaptive {
+		err = errors.New("试卷未开启自适应出题")
+		return
+	}
+	state, err := parseAdaptiveState(examineeAnswer.AdaptiveState)
+	if err != nil {
+		l.Errorf("NextQuestion.parseAdaptiveState Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	questions, mQuestionOptions, err := uc.questionUc.GetQuestionsWithOptions(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	mQuestion := make(map[string]*entity.Question, len(questions))
+	for _, question := range questions {
+		mQuestion[question.ID] = question
+	}
+	if req.Answer != nil {
+		// 1. 保存当前题目的答案并更新能力估计
+		if state.Pending == "" || req.Answer.QuestionId != state.Pending {
+			err = errors.New("只能作答当前题目")
+			return
+		}
+		answerData, invalid, e := uc.validateAnswers(ctx, examineeAnswer, []*v1.QuestionAnswerData{req.Answer})
+		if e != nil {
+			l.Errorf("NextQuestion.validateAnswers Failed, req:%v, err:%v", req, e.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		if len(invalid) > 0 {
+			err = innErr.ErrInvalidAnswer.WithMetadata(invalid)
+			return
+		}
+		if err = uc.saveAnswers(ctx, examineeAnswer.ID, answerData); err != nil {
+			l.Errorf("NextQuestion.saveAnswers Failed, req:%v, err:%v", req, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		question := mQuestion[state.Pending]
+		for _, dimension := range state.Dimensions {
+			if question == nil || dimension.DimensionID != question.DimensionID {
+				continue
+			}
+			dimension.Items = append(dimension.Items, &AdaptiveItem{
+				QuestionID: question.ID,
+				Correct:    adaptiveCorrect(question, mQuestionOptions[question.ID], answerData[0]),
+			})
+			dimension.Theta, dimension.SE = estimateTheta(dimension.Items, mQuestion)
+		}
+		state.Pending = ""
+	}
+	if state.Pending == "" {
+		// 2. 选出下一题，记入本次作答的题目
+		config, e := ParseAdaptiveConfig(salesPaper.AdaptiveConfig)
+		if e != nil {
+			l.Errorf("NextQuestion.ParseAdaptiveConfig Failed, salesPaperId:%v, err:%v", salesPaper.ID, e.Error())
+		}
+		dimensions, e := uc.salesPaperUc.GetDimensionList(ctx, examineeAnswer.SalesPaperID)
+		if e != nil {
+			err = e
+			return
+		}
+		administered := attemptQuestionIds(examineeAnswer)
+		questionIds := make([]string, 0, len(administered)+1)
+		json.Unmarshal([]byte(examineeAnswer.QuestionIds), &questionIds)
+		if next := nextAdaptiveQuestion(state, config, dimensions, questions, administered); next != nil {
+			state.Pending = next.ID
+			questionIds = append(questionIds, next.ID)
+		}
+		value, _ := json.Marshal(state)
+		ids, _ := json.Marshal(questionIds)
+		rowsAffected, e := uc.repo.UpdateAdaptiveState(ctx, examineeAnswer.ID, string(value), string(ids), examineeAnswer.AdaptiveState)
+		if e != nil {
+			l.Errorf("NextQuestion.repo.UpdateAdaptiveState Failed, req:%v, err:%v", req, e.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		if rowsAffected == 0 {
+			err = errors.New("系统繁忙，请稍后重试")
+			return
+		}
+		examineeAnswer.QuestionIds = string(ids)
+	}
+	resp.AnsweredCount = state.answeredCount()
+	if state.Pending == "" {
+		resp.Finished = true
+		return
+	}
+	// 3. 返回题目：按试卷设置打乱选项，附件换成签名地址
+	questionData, err := uc.attemptQuestions(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("NextQuestion.attemptQuestions Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	for _, question := range questionData {
+		if question.QuestionId != state.Pending {
+			continue
+		}
+		list := shuffleQuestions([]*v1.QuestionData{question}, examineeAnswer.ID, false, salesPaper.ShuffleOptions)
+		if err = uc.signAttachments(ctx, list); err != nil {
+			l.Errorf("NextQuestion.signAttachments Failed, req:%v, err:%v", req, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		resp.Question = list[0]
+		resp.Question.Order = resp.AnsweredCount + 1
+	}
+	return
+}
@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"math"
+	"testing"
+
+	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/data/entity"
+)
+
+func TestParseAdaptiveConfig(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  string
+		want    AdaptiveConfig
+		wantErr bool
+	}{
+		{"未配置使用默认值", "", defaultAdaptiveConfig, false},
+		{"覆盖部分配置", `{"max_items":5}`, AdaptiveConfig{SEThreshold: 0.3, MaxItems: 5}, false},
+		{"覆盖全部配置", `{"max_items":8,"se_threshold":0.5}`, AdaptiveConfig{SEThreshold: 0.5, MaxItems: 8}, false},
+		{"格式错误", `{"max_items":`, defaultAdaptiveConfig, true},
+		{"题数上限为0", `{"max_items":0}`, defaultAdaptiveConfig, true},
+		{"题数上限为负数", `{"max_items":-1}`, defaultAdaptiveConfig, true},
+		{"标准误阈值为0", `{"se_threshold":0}`, defaultAdaptiveConfig, true},
+	}
+	for _, c := range cases {
+		got, err := ParseAdaptiveConfig(c.config)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestEstimateTheta(t *testing.T) {
+	mQuestion := map[string]*entity.Question{
+		"Q1": {ID: "Q1", IrtDiscrimination: 1.5, IrtDifficulty: -1},
+		"Q2": {ID: "Q2", IrtDiscrimination: 1.5, IrtDifficulty: 0},
+		"Q3": {ID: "Q3", IrtDiscrimination: 1.5, IrtDifficulty: 1},
+	}
+	all := func(correct bool) []*AdaptiveItem {
+		return []*AdaptiveItem{{"Q1", correct}, {"Q2", correct}, {"Q3", correct}}
+	}
+	cases := []struct {
+		name     string
+		items    []*AdaptiveItem
+		minTheta float64
+		maxTheta float64
+		maxSE    float64
+	}{
+		{"未作答取先验均值", nil, -0.01, 0.01, 1.01},
+		{"全部答对能力为正且有限", all(true), 0.5, 4, 1},
+		{"全部答错能力为负且有限", all(false), -4, -0.5, 1},
+		{"难度居中的题一对一错接近0", []*AdaptiveItem{{"Q1", true}, {"Q3", false}}, -0.3, 0.3, 1},
+		{"不在题库中的题目不参与估计", []*AdaptiveItem{{"QX", true}}, -0.01, 0.01, 1.01},
+	}
+	for _, c := range cases {
+		theta, se := estimateTheta(c.items, mQuestion)
+		if theta < c.minTheta || theta > c.maxTheta || math.IsNaN(theta) {
+			t.Errorf("%s: theta = %v, want in [%v, %v]", c.name, theta, c.minTheta, c.maxTheta)
+		}
+		if se <= 0 || se > c.maxSE {
+			t.Errorf("%s: se = %v, want in (0, %v]", c.name, se, c.maxSE)
+		}
+	}
+	// 作答越多标准误越小
+	_, se1 := estimateTheta(all(true)[:1], mQuestion)
+	_, se3 := estimateTheta(all(true), mQuestion)
+	if se3 >= se1 {
+		t.Errorf("se after 3 items = %v, want < %v", se3, se1)
+	}
+}
+
+func TestNextAdaptiveQuestion(t *testing.T) {
+	dimensions := []*entity.SalesPaperDimension{{ID: "D1"}, {ID: "D2"}}
+	questions := []*entity.Question{
+		{ID: "Q1", DimensionID: "D1", IrtDiscrimination: 1, IrtDifficulty: -2},
+		{ID: "Q2", DimensionID: "D1", IrtDiscrimination: 1, IrtDifficulty: 0},
+		{ID: "Q3", DimensionID: "D2", IrtDiscrimination: 1, IrtDifficulty: 2},
+	}
+	config := AdaptiveConfig{SEThreshold: 0.3, MaxItems: 2}
+	cases := []struct {
+		name         string
+		state        *AdaptiveState
+		administered map[string]struct{}
+		want         string
+		wantDone     []string
+	}{
+		{
+			name:  "第一题选当前能力处信息量最大的题",
+			state: &AdaptiveState{},
+			want:  "Q2",
+		},
+		{
+			name:         "已下发的题不再选",
+			state:        &AdaptiveState{Dimensions: []*AdaptiveDimension{{DimensionID: "D1", SE: 0.8, Items: []*AdaptiveItem{{"Q2", true}}}}},
+			administered: map[string]struct{}{"Q2": {}},
+			want:         "Q1",
+		},
+		{
+			name:         "达到题数上限后进入下一维度",
+			state:        &AdaptiveState{Dimensions: []*AdaptiveDimension{{DimensionID: "D1", SE: 0.8, Items: []*AdaptiveItem{{"Q2", true}, {"Q1", true}}}}},
+			administered: map[string]struct{}{"Q1": {}, "Q2": {}},
+			want:         "Q3",
+			wantDone:     []string{"D1"},
+		},
+		{
+			name:         "标准误达到阈值后进入下一维度",
+			state:        &AdaptiveState{Dimensions: []*AdaptiveDimension{{DimensionID: "D1", SE: 0.2, Items: []*AdaptiveItem{{"Q2", true}}}}},
+			administered: map[string]struct{}{"Q2": {}},
+			want:         "Q3",
+			wantDone:     []string{"D1"},
+		},
+		{
+			name: "全部维度结束",
+			state: &AdaptiveState{Dimensions: []*AdaptiveDimension{
+				{DimensionID: "D1", Done: true},
+				{DimensionID: "D2", SE: 0.8, Items: []*AdaptiveItem{{"Q3", true}}},
+			}},
+			administered: map[string]struct{}{"Q1": {}, "Q2": {}, "Q3": {}},
+			wantDone:     []string{"D1", "D2"},
+		},
+	}
+	for _, c := range cases {
+		got := nextAdaptiveQuestion(c.state, config, dimensions, questions, c.administered)
+		gotId := ""
+		if got != nil {
+			gotId = got.ID
+		}
+		if gotId != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, gotId, c.want)
+		}
+		for _, dimensionId := range c.wantDone {
+			for _, dimension := range c.state.Dimensions {
+				if dimension.DimensionID == dimensionId && !dimension.Done {
+					t.Errorf("%s: dimension %s not done", c.name, dimensionId)
+				}
+			}
+		}
+	}
+}
+
+func TestSameAnswer(t *testing.T) {
+	saved := &entity.ExamineeAnswerQuestionAnswer{OptionSign: `["A","C"]`}
+	cases := []struct {
+		name   string
+		saved  *entity.ExamineeAnswerQuestionAnswer
+		answer *v1.QuestionAnswerData
+		want   bool
+	}{
+		{"原样重复提交", saved, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"A", "C"}}, true},
+		{"修改选项", saved, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"A"}}, false},
+		{"选项顺序不同", saved, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"C", "A"}}, false},
+		{"未保存过答案", nil, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"A"}}, false},
+		{"文字答案相同", &entity.ExamineeAnswerQuestionAnswer{OptionSign: "null", TextAnswer: "42"}, &v1.QuestionAnswerData{TextAnswer: "42"}, true},
+		{"文字答案不同", &entity.ExamineeAnswerQuestionAnswer{OptionSign: "null", TextAnswer: "42"}, &v1.QuestionAnswerData{TextAnswer: "43"}, false},
+	}
+	for _, c := range cases {
+		if got := sameAnswer(c.saved, c.answer); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -3,16 +3,20 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	v1 "exam_api/api/exam_api/v1"
 	_const "exam_api/internal/const"
 	"exam_api/internal/data/entity"
 	"exam_api/internal/pkg/icontext"
 	innErr "exam_api/internal/pkg/ierrors"
 	"exam_api/internal/pkg/isnowflake"
 	"github.com/go-kratos/kratos/v2/log"
+	"time"
 )
 
 type ExamEventRepo interface {
 	ExamEvent(ctx context.Context, examEvent *entity.ExamEvent) error
+	BatchCreate(ctx context.Context, examEvents []*entity.ExamEvent) error
+	GetByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamEvent, err error)
 }
 
 type ExamEventUseCase struct {
@@ -53,3 +57,45 @@ func (uc *ExamEventUseCase) ExamEvent(ctx context.Context, examineeAnswerId stri
 	}
 	return
 }
+
+// BatchClientEvent 批量保存浏览器上报的事件，会话、令牌、IP等信息取自当前请求
+func (uc *ExamEventUseCase) BatchClientEvent(ctx context.Context, examineeAnswerId string, events []*v1.ClientExamEvent) (err error) {
+	l := uc.log.WithContext(ctx)
+	sessionId, _ := icontext.SessionIdFrom(ctx)
+	examToken, _ := icontext.ExamTokenFrom(ctx)
+	client, _ := icontext.UserClientFrom(ctx)
+	userId, _ := icontext.UserIdFrom(ctx)
+	userAgent, _ := icontext.UserAgentFrom(ctx)
+	examEvents := make([]*entity.ExamEvent, 0, len(events))
+	for _, event := range events {
+		id, _ := isnowflake.SnowFlake.NextID(_const.ExamiEventPrefix)
+		metaStr, _ := json.Marshal(event.Meta)
+		examEvent := &entity.ExamEvent{
+			ID:               id,
+			SessionID:        sessionId,
+			ExamToken:        examToken,
+			ExamineeAnswerID: examineeAnswerId,
+			EventType:        event.EventType,
+			IP:               client.IP,
+			UserAgent:        userAgent,
+			Meta:             string(metaStr),
+			CreatedBy:        userId,
+		}
+		if event.ClientTime > 0 {
+			clientTime := time.UnixMilli(event.ClientTime)
+			examEvent.ClientTime = &clientTime
+		}
+		examEvents = append(examEvents, examEvent)
+	}
+	err = uc.repo.BatchCreate(ctx, examEvents)
+	if err != nil {
+		l.Errorf("BatchClientEvent.repo.BatchCreate Failed, examineeAnswerId:%v, err:%v", examineeAnswerId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+func (uc *ExamEventUseCase) GetByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamEvent, err error) {
+	return uc.repo.GetByExamineeAnswerId(ctx, examineeAnswerId)
+}
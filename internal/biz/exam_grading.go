@@ -0,0 +1,221 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "exam_api/api/exam_api/v1"
+	_const "exam_api/internal/const"
+	"exam_api/internal/data/entity"
+	"exam_api/internal/pkg/icontext"
+	innErr "exam_api/internal/pkg/ierrors"
+	"exam_api/internal/pkg/itask"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// numericEpsilon 比较数值误差时的浮点容差，避免 3.14-3.13 这类边界值因精度被判错
+const numericEpsilon = 1e-9
+
+// numericAnswerKey 数值题参考答案
+type numericAnswerKey struct {
+	Value     float64 `json:"value"`     // 参考值
+	Tolerance float64 `json:"tolerance"` // 允许误差（绝对值）
+}
+
+// gradeTextAnswers 为文字题型评分：填空题和数值题按参考答案自动评分，问答题进入人工评分队列。
+// 返回仍待人工评分的数量，出错时返回失败原因
+func (uc *ExamineeAnswerScoreUseCase) gradeTextAnswers(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) (pending int, reason string, err error) {
+	questions, _, err := uc.questionUc.GetQuestionsWithOptions(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		reason = "获取试卷题目失败"
+		return
+	}
+	answers, err := uc.examineeQuestionAnswerUC.GetByExamineeAnswerId(ctx, examineeAnswer.ID)
+	if err != nil {
+		reason = "获取作答记录失败"
+		return
+	}
+	mQuestion := make(map[string]*entity.Question, len(questions))
+	for _, question := range questions {
+		mQuestion[question.ID] = question
+	}
+	graded := make([]*entity.ExamineeAnswerQuestionAnswer, 0)
+	for _, answer := range answers {
+		question, ok := mQuestion[answer.QuestionID]
+		if !ok || !isTextQuestion(v1.QuestionType(question.QuestionTypeID)) || answer.GradeStatus == GradeStatusGraded {
+			continue
+		}
+		score, status := autoGrade(question, answer.TextAnswer)
+		if status == GradeStatusPending {
+			pending++
+		}
+		if score == answer.Score && status == answer.GradeStatus {
+			continue
+		}
+		answer.Score, answer.GradeStatus = score, status
+		graded = append(graded, answer)
+	}
+	if len(graded) == 0 {
+		return
+	}
+	if err = uc.examineeQuestionAnswerUC.UpdateGrades(ctx, graded); err != nil {
+		reason = "保存评分结果失败"
+	}
+	return
+}
+
+// autoGrade 按参考答案给文字题型评分：填空题与任一可接受答案一致（忽略首尾空格和大小写）得满分，
+// 数值题与参考值的误差不超过允许误差得满分；问答题有作答时待人工评分，未作答得0分
+func autoGrade(question *entity.Question, textAnswer string) (score float64, status int32) {
+	text := strings.TrimSpace(textAnswer)
+	if text == "" {
+		return 0, GradeStatusNone
+	}
+	switch v1.QuestionType(question.QuestionTypeID) {
+	case v1.QuestionType_FillIn:
+		accepted := make([]string, 0)
+		json.Unmarshal([]byte(question.AnswerKey), &accepted)
+		for _, v := range accepted {
+			if strings.EqualFold(strings.TrimSpace(v), text) {
+				return question.FullScore, GradeStatusNone
+			}
+		}
+	case v1.QuestionType_Numeric:
+		var key numericAnswerKey
+		value, err := strconv.ParseFloat(text, 64)
+		if err == nil && json.Unmarshal([]byte(question.AnswerKey), &key) == nil && math.Abs(value-key.Value) <= key.Tolerance+numericEpsilon {
+			return question.FullScore, GradeStatusNone
+		}
+	case v1.QuestionType_Essay:
+		return 0, GradeStatusPending
+	}
+	return 0, GradeStatusNone
+}
+
+// checkGrader 只有评分人员可以评分
+func checkGrader(ctx context.Context) error {
+	role, _ := icontext.UserRuleFrom(ctx)
+	if role != _const.RoleGrader {
+		return innErr.ErrGradingForbidden
+	}
+	return nil
+}
+
+// GetGradingQueue 分页获取待人工评分的问答题答案
+func (uc *ExamineeAnswerScoreUseCase) GetGradingQueue(ctx context.Context, req *v1.GetGradingQueueRequest) (resp *v1.GetGradingQueueResponse, err error) {
+	resp = &v1.GetGradingQueueResponse{Items: make([]*v1.GradingItem, 0)}
+	if err = checkGrader(ctx); err != nil {
+		return
+	}
+	if req.PageIndex == 0 {
+		req.PageIndex = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 10
+	}
+	l := uc.log.WithContext(ctx)
+	list, total, err := uc.examineeQuestionAnswerUC.GetPendingGradingPageList(ctx, req.PageIndex, req.PageSize)
+	if err != nil {
+		l.Errorf("GetGradingQueue.examineeQuestionAnswerUC.GetPendingGradingPageList Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	resp.Total = total
+	questionIds := make([]string, 0, len(list))
+	for _, answer := range list {
+		questionIds = append(questionIds, answer.QuestionID)
+	}
+	mQuestion, err := uc.questionUc.GetQuestionMap(ctx, questionIds)
+	if err != nil {
+		return
+	}
+	for _, answer := range list {
+		item := &v1.GradingItem{
+			AnswerId:         answer.ID,
+			ExamineeAnswerId: answer.ExamineeAnswerID,
+			QuestionId:       answer.QuestionID,
+			TextAnswer:       answer.TextAnswer,
+		}
+		if question, ok := mQuestion[answer.QuestionID]; ok {
+			item.Title = question.Title
+			item.FullScore = question.FullScore
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return
+}
+
+// GradeAnswer 问答题人工评分，答卷的问答题全部评完后自动算分
+func (uc *ExamineeAnswerScoreUseCase) GradeAnswer(ctx context.Context, req *v1.GradeAnswerRequest) (resp *v1.GradeAnswerResponse, err error) {
+	resp = &v1.GradeAnswerResponse{}
+	if err = checkGrader(ctx); err != nil {
+		return
+	}
+	var (
+		l         = uc.log.WithContext(ctx)
+		userId, _ = icontext.UserIdFrom(ctx)
+	)
+	answer, err := uc.examineeQuestionAnswerUC.GetById(ctx, req.AnswerId)
+	if err != nil {
+		l.Errorf("GradeAnswer.examineeQuestionAnswerUC.GetById Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if answer == nil || answer.GradeStatus != GradeStatusPending {
+		err = errors.New("答案不存在或无需评分")
+		return
+	}
+	mQuestion, err := uc.questionUc.GetQuestionMap(ctx, []string{answer.QuestionID})
+	if err != nil {
+		return
+	}
+	question, ok := mQuestion[answer.QuestionID]
+	if !ok {
+		err = errors.New("题目不存在")
+		return
+	}
+	if req.Score < 0 || req.Score > question.FullScore {
+		err = innErr.WithMessage(innErr.ErrBadRequest, "得分须在0到"+strconv.FormatFloat(question.FullScore, 'f', -1, 64)+"之间")
+		return
+	}
+	ok, err = uc.examineeQuestionAnswerUC.UpdateManualGrade(ctx, answer.ID, req.Score, userId, time.Now())
+	if err != nil {
+		l.Errorf("GradeAnswer.examineeQuestionAnswerUC.UpdateManualGrade Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if !ok {
+		err = errors.New("该答案已评分")
+		return
+	}
+	resp.Remaining, err = uc.examineeQuestionAnswerUC.CountPendingGrading(ctx, answer.ExamineeAnswerID)
+	if err != nil {
+		l.Errorf("GradeAnswer.examineeQuestionAnswerUC.CountPendingGrading Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if resp.Remaining > 0 {
+		return
+	}
+	// 全部评完，异步算分（脱离请求上下文，避免请求结束后被取消）
+	examineeAnswer, err := uc.examineeAnswerRepo.GetById(ctx, answer.ExamineeAnswerID)
+	if err != nil {
+		l.Errorf("GradeAnswer.examineeAnswerRepo.GetById Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if examineeAnswer == nil {
+		return
+	}
+	scoreCtx := icontext.Detach(ctx)
+	associationId := examineeAnswer.ExamineeSalesPaperAssociationID
+	go itask.TaskWithContext(scoreCtx, func() {
+		if e := uc.CalculatePoints(scoreCtx, associationId); e != nil {
+			l.Errorf("GradeAnswer.CalculatePoints Failed, associationId:%v, err:%v", associationId, e.Error())
+		}
+	}, l)
+	return
+}
@@ -0,0 +1,43 @@
+package biz
+
+import (
+	"testing"
+
+	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/data/entity"
+)
+
+func TestAutoGrade(t *testing.T) {
+	fillIn := &entity.Question{QuestionTypeID: int32(v1.QuestionType_FillIn), FullScore: 5, AnswerKey: `["Paris", " 巴黎 "]`}
+	numeric := &entity.Question{QuestionTypeID: int32(v1.QuestionType_Numeric), FullScore: 4, AnswerKey: `{"value":3.14,"tolerance":0.01}`}
+	exact := &entity.Question{QuestionTypeID: int32(v1.QuestionType_Numeric), FullScore: 4, AnswerKey: `{"value":42}`}
+	essay := &entity.Question{QuestionTypeID: int32(v1.QuestionType_Essay), FullScore: 10}
+	cases := []struct {
+		name       string
+		question   *entity.Question
+		text       string
+		wantScore  float64
+		wantStatus int32
+	}{
+		{"填空题完全一致", fillIn, "Paris", 5, GradeStatusNone},
+		{"填空题忽略大小写和空格", fillIn, "  pARIS ", 5, GradeStatusNone},
+		{"填空题任一可接受答案", fillIn, "巴黎", 5, GradeStatusNone},
+		{"填空题答错", fillIn, "London", 0, GradeStatusNone},
+		{"填空题参考答案格式错误", &entity.Question{QuestionTypeID: int32(v1.QuestionType_FillIn), FullScore: 5, AnswerKey: "Paris"}, "Paris", 0, GradeStatusNone},
+		{"数值题误差内", numeric, "3.145", 4, GradeStatusNone},
+		{"数值题误差边界", numeric, "3.13", 4, GradeStatusNone},
+		{"数值题超出误差", numeric, "3.2", 0, GradeStatusNone},
+		{"数值题非数字", numeric, "pi", 0, GradeStatusNone},
+		{"数值题零误差", exact, " 42 ", 4, GradeStatusNone},
+		{"数值题零误差答错", exact, "42.5", 0, GradeStatusNone},
+		{"问答题待人工评分", essay, "我的回答", 0, GradeStatusPending},
+		{"问答题未作答", essay, "   ", 0, GradeStatusNone},
+		{"未作答", fillIn, "", 0, GradeStatusNone},
+	}
+	for _, c := range cases {
+		score, status := autoGrade(c.question, c.text)
+		if score != c.wantScore || status != c.wantStatus {
+			t.Errorf("%s: autoGrade(%q) = (%v, %d), want (%v, %d)", c.name, c.text, score, status, c.wantScore, c.wantStatus)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"encoding/json"
+	_const "exam_api/internal/const"
+	"exam_api/internal/data/entity"
+	"math"
+)
+
+// 作答有效性（ExamineeAnswer.Usability）
+const (
+	UsabilityValid      int32 = 1 // 有效
+	UsabilityMostly     int32 = 2 // 基本有效
+	UsabilitySuspicious int32 = 3 // 存疑
+	UsabilityInvalid    int32 = 4 // 无效
+)
+
+// IntegrityThresholds 作答异常判定阈值，试卷通过 SalesPaper.IntegrityConfig 覆盖，未配置的项使用默认值
+type IntegrityThresholds struct {
+	MaxSwitchTab          int     `json:"max_switch_tab"`           // 切换标签页次数上限
+	MaxVisibilityHidden   int     `json:"max_visibility_hidden"`    // 页面不可见次数上限
+	MaxPaste              int     `json:"max_paste"`                // 粘贴次数上限
+	MaxCopy               int     `json:"max_copy"`                 // 复制次数上限
+	MaxLongInactive       int     `json:"max_long_inactive"`        // 长时间无心跳次数上限
+	MaxIPChanges          int     `json:"max_ip_changes"`           // IP变化次数上限
+	MaxUserAgentChanges   int     `json:"max_user_agent_changes"`   // UserAgent变化次数上限
+	MinSecondsPerQuestion float64 `json:"min_seconds_per_question"` // 平均每题最少用时（秒）
+}
+
+var defaultIntegrityThresholds = IntegrityThresholds{
+	MaxSwitchTab:          5,
+	MaxVisibilityHidden:   5,
+	MaxPaste:              3,
+	MaxCopy:               3,
+	MaxLongInactive:       2,
+	MaxIPChanges:          1,
+	MaxUserAgentChanges:   0,
+	MinSecondsPerQuestion: 2,
+}
+
+// IntegrityFlag 一项作答异常
+type IntegrityFlag struct {
+	Code      string  `json:"code"`      // 异常编码
+	Value     float64 `json:"value"`     // 实际值
+	Threshold float64 `json:"threshold"` // 判定阈值
+	Penalty   float64 `json:"penalty"`   // 扣分
+}
+
+// IntegrityResult 作答诚信分析结果
+type IntegrityResult struct {
+	Score     float64          // 诚信分（0~100）
+	Usability int32            // 作答有效性（1~4）
+	Flags     []*IntegrityFlag // 异常标记
+}
+
+// 各项异常的扣分
+var integrityPenalties = map[string]float64{
+	"switch_tab":        15,
+	"visibility_hidden": 10,
+	"paste":             20,
+	"copy":              10,
+	"long_inactive":     10,
+	"ip_change":         20,
+	"user_agent_change": 25,
+	"fast_answering":    30,
+}
+
+// ParseIntegrityThresholds 解析试卷配置的阈值，未配置或配置无效时使用默认值
+func ParseIntegrityThresholds(config string) (IntegrityThresholds, error) {
+	thresholds := defaultIntegrityThresholds
+	if config == "" {
+		return thresholds, nil
+	}
+	if err := json.Unmarshal([]byte(config), &thresholds); err != nil {
+		return defaultIntegrityThresholds, err
+	}
+	return thresholds, nil
+}
+
+// AnalyzeIntegrity 汇总一次作答的考试事件，超过阈值的项记为异常并扣分，按诚信分得出作答有效性
+func AnalyzeIntegrity(examineeAnswer *entity.ExamineeAnswer, events []*entity.ExamEvent, thresholds IntegrityThresholds) *IntegrityResult {
+	var (
+		counts                      = make(map[_const.ExamEventType]int)
+		ipChanges, userAgentChanges int
+		lastIP, lastUserAgent       string
+	)
+	for _, event := range events {
+		counts[_const.ExamEventType(event.EventType)]++
+		if event.IP != "" {
+			if lastIP != "" && event.IP != lastIP {
+				ipChanges++
+			}
+			lastIP = event.IP
+		}
+		if event.UserAgent != "" {
+			if lastUserAgent != "" && event.UserAgent != lastUserAgent {
+				userAgentChanges++
+			}
+			lastUserAgent = event.UserAgent
+		}
+	}
+
+	result := &IntegrityResult{Score: 100, Flags: make([]*IntegrityFlag, 0)}
+	check := func(code string, value, threshold float64, exceeded bool) {
+		if !exceeded {
+			return
+		}
+		flag := &IntegrityFlag{Code: code, Value: value, Threshold: threshold, Penalty: integrityPenalties[code]}
+		result.Flags = append(result.Flags, flag)
+		result.Score -= flag.Penalty
+	}
+	countCheck := func(code string, value, max int) {
+		check(code, float64(value), float64(max), value > max)
+	}
+	countCheck("switch_tab", counts[_const.ExamEventSwitchTab], thresholds.MaxSwitchTab)
+	countCheck("visibility_hidden", counts[_const.ExamEventVisibilityHidden], thresholds.MaxVisibilityHidden)
+	countCheck("paste", counts[_const.ExamEventPaste], thresholds.MaxPaste)
+	countCheck("copy", counts[_const.ExamEventCopy], thresholds.MaxCopy)
+	countCheck("long_inactive", counts[_const.ExamEventLongInactive], thresholds.MaxLongInactive)
+	countCheck("ip_change", ipChanges, thresholds.MaxIPChanges)
+	countCheck("user_agent_change", userAgentChanges, thresholds.MaxUserAgentChanges)
+	// 平均每题用时过短
+	if examineeAnswer.SubmitTime != nil && examineeAnswer.CompleteQuestionNum > 0 {
+		seconds := examineeAnswer.SubmitTime.Sub(examineeAnswer.BeginTestTime).Seconds()
+		perQuestion := math.Round(seconds/float64(examineeAnswer.CompleteQuestionNum)*100) / 100
+		check("fast_answering", perQuestion, thresholds.MinSecondsPerQuestion, perQuestion < thresholds.MinSecondsPerQuestion)
+	}
+
+	result.Score = math.Max(result.Score, 0)
+	switch {
+	case result.Score >= 90:
+		result.Usability = UsabilityValid
+	case result.Score >= 70:
+		result.Usability = UsabilityMostly
+	case result.Score >= 50:
+		result.Usability = UsabilitySuspicious
+	default:
+		result.Usability = UsabilityInvalid
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	_const "exam_api/internal/const"
+	"exam_api/internal/data/entity"
+)
+
+func TestAnalyzeIntegrity(t *testing.T) {
+	begin := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	submit := begin.Add(10 * time.Minute)
+	answer := &entity.ExamineeAnswer{BeginTestTime: begin, SubmitTime: &submit, CompleteQuestionNum: 20}
+	events := func(eventType _const.ExamEventType, n int) []*entity.ExamEvent {
+		res := make([]*entity.ExamEvent, 0, n)
+		for i := 0; i < n; i++ {
+			res = append(res, &entity.ExamEvent{EventType: string(eventType)})
+		}
+		return res
+	}
+	withClient := func(ips ...string) []*entity.ExamEvent {
+		res := make([]*entity.ExamEvent, 0, len(ips))
+		for _, ip := range ips {
+			res = append(res, &entity.ExamEvent{EventType: string(_const.ExamEventHeartbeat), IP: ip, UserAgent: "ua"})
+		}
+		return res
+	}
+	fastSubmit := begin.Add(20 * time.Second)
+	cases := []struct {
+		name           string
+		examineeAnswer *entity.ExamineeAnswer
+		events         []*entity.ExamEvent
+		wantScore      float64
+		wantUsability  int32
+		wantFlags      []string
+	}{
+		{"没有异常", answer, nil, 100, UsabilityValid, nil},
+		{"切换标签页未超过阈值", answer, events(_const.ExamEventSwitchTab, 5), 100, UsabilityValid, nil},
+		{"切换标签页超过阈值", answer, events(_const.ExamEventSwitchTab, 6), 85, UsabilityMostly, []string{"switch_tab"}},
+		{"粘贴超过阈值", answer, events(_const.ExamEventPaste, 4), 80, UsabilityMostly, []string{"paste"}},
+		{"IP变化两次", answer, withClient("1.1.1.1", "2.2.2.2", "1.1.1.1"), 80, UsabilityMostly, []string{"ip_change"}},
+		{"IP变化一次不超过阈值", answer, withClient("1.1.1.1", "2.2.2.2"), 100, UsabilityValid, nil},
+		{"缺少IP的事件不算变化", answer, withClient("1.1.1.1", "", "1.1.1.1"), 100, UsabilityValid, nil},
+		{"平均每题用时过短", &entity.ExamineeAnswer{BeginTestTime: begin, SubmitTime: &fastSubmit, CompleteQuestionNum: 20}, nil, 70, UsabilityMostly, []string{"fast_answering"}},
+		{"未交卷不判断用时", &entity.ExamineeAnswer{BeginTestTime: begin, CompleteQuestionNum: 20}, nil, 100, UsabilityValid, nil},
+		{
+			"多项异常扣到存疑",
+			answer,
+			append(events(_const.ExamEventPaste, 4), events(_const.ExamEventSwitchTab, 6)...),
+			65, UsabilitySuspicious, []string{"switch_tab", "paste"},
+		},
+		{
+			"扣分不低于0",
+			&entity.ExamineeAnswer{BeginTestTime: begin, SubmitTime: &fastSubmit, CompleteQuestionNum: 20},
+			append(append(append(append(events(_const.ExamEventPaste, 4), events(_const.ExamEventSwitchTab, 6)...), events(_const.ExamEventCopy, 4)...), events(_const.ExamEventLongInactive, 3)...), withClient("1.1.1.1", "2.2.2.2", "1.1.1.1")...),
+			0, UsabilityInvalid, []string{"switch_tab", "paste", "copy", "long_inactive", "ip_change", "fast_answering"},
+		},
+	}
+	for _, c := range cases {
+		result := AnalyzeIntegrity(c.examineeAnswer, c.events, defaultIntegrityThresholds)
+		if result.Score != c.wantScore || result.Usability != c.wantUsability {
+			t.Errorf("%s: score = %v, usability = %v, want %v, %v", c.name, result.Score, result.Usability, c.wantScore, c.wantUsability)
+		}
+		flags := make([]string, 0, len(result.Flags))
+		for _, flag := range result.Flags {
+			flags = append(flags, flag.Code)
+		}
+		if len(flags) != len(c.wantFlags) {
+			t.Errorf("%s: flags = %v, want %v", c.name, flags, c.wantFlags)
+			continue
+		}
+		for i := range flags {
+			if flags[i] != c.wantFlags[i] {
+				t.Errorf("%s: flags = %v, want %v", c.name, flags, c.wantFlags)
+				break
+			}
+		}
+	}
+}
+
+func TestParseIntegrityThresholds(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  string
+		want    IntegrityThresholds
+		wantErr bool
+	}{
+		{"未配置使用默认值", "", defaultIntegrityThresholds, false},
+		{"覆盖部分阈值", `{"max_paste":10}`, func() IntegrityThresholds {
+			thresholds := defaultIntegrityThresholds
+			thresholds.MaxPaste = 10
+			return thresholds
+		}(), false},
+		{"格式错误使用默认值", `{"max_paste":`, defaultIntegrityThresholds, true},
+	}
+	for _, c := range cases {
+		got, err := ParseIntegrityThresholds(c.config)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	v1 "exam_api/api/exam_api/v1"
+	_const "exam_api/internal/const"
+	"exam_api/internal/data/entity"
+	"exam_api/internal/pkg/icontext"
+	innErr "exam_api/internal/pkg/ierrors"
+)
+
+// inBand 分数是否落在评语区间内（含上下限）
+func inBand(score, lowScore, upScore float64) bool {
+	return score >= lowScore && score <= upScore
+}
+
+// canViewResult 考生只能查看自己的考试，招聘人员只能查看自己安排（创建关联）的考试
+func canViewResult(association *entity.ExamineeSalesPaperAssociation, userId string, recruiter bool) bool {
+	if recruiter {
+		return association.CreatedBy == userId
+	}
+	return association.ExamineeID == userId
+}
+
+// GetExamResult 获取已算分考试的结果报告：总分、各维度标准分及所在区间的评语。
+// 考生查看时可见范围由试卷控制；招聘人员查看自己安排的考试时返回完整报告
+func (uc *ExamineeAnswerScoreUseCase) GetExamResult(ctx context.Context, req *v1.GetExamResultRequest) (resp *v1.GetExamResultResponse, err error) {
+	resp = &v1.GetExamResultResponse{Dimensions: make([]*v1.DimensionResult, 0)}
+	var (
+		l         = uc.log.WithContext(ctx)
+		userId, _ = icontext.UserIdFrom(ctx)
+		role, _   = icontext.UserRuleFrom(ctx)
+		recruiter = role == _const.RoleRecruiter
+	)
+	association, err := uc.associationUc.GetById(ctx, req.AssociationId)
+	if err != nil {
+		l.Errorf("GetExamResult.associationUc.GetById Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if association == nil || !canViewResult(association, userId, recruiter) {
+		err = errors.New("考试不存在")
+		return
+	}
+	if association.StageNumber != int32(v1.StageNumber_CalculatePoints) {
+		err = errors.New("考试结果尚未生成")
+		return
+	}
+	mSalesPaper, err := uc.salesPaperUc.GetSalesPaperMap(ctx, []string{association.SalesPaperID})
+	if err != nil {
+		return
+	}
+	salesPaper, ok := mSalesPaper[association.SalesPaperID]
+	if !ok {
+		err = errors.New("试卷不存在")
+		return
+	}
+	resp.SalesPaperName = salesPaper.Name
+	resp.Visibility = v1.ResultVisibility(salesPaper.ResultVisibility)
+	if recruiter {
+		resp.Visibility = v1.ResultVisibility_ResultFull
+	}
+	if resp.Visibility == v1.ResultVisibility_ResultHidden {
+		return
+	}
+	examineeAnswer, err := uc.examineeAnswerRepo.GetByAssociationId(ctx, req.AssociationId)
+	if err != nil {
+		l.Errorf("GetExamResult.examineeAnswerRepo.GetByAssociationId Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if examineeAnswer == nil {
+		err = errors.New("答卷不存在")
+		return
+	}
+	scores, err := uc.repo.GetByExamineeAnswerId(ctx, examineeAnswer.ID)
+	if err != nil {
+		l.Errorf("GetExamResult.repo.GetByExamineeAnswerId Failed, examineeAnswer.ID:%v, err:%v", examineeAnswer.ID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	dimensions, err := uc.salesPaperUc.GetDimensionList(ctx, association.SalesPaperID)
+	if err != nil {
+		return
+	}
+	resp.Score = examineeAnswer.Score
+	mStandardScore := make(map[string]float64, len(scores))
+	for _, score := range scores {
+		mStandardScore[score.DimensionID] = score.DimensionStandardScore
+	}
+	// 按试卷维度顺序返回，没有得分记录的维度不返回
+	dimensionIds := make([]string, 0, len(dimensions))
+	for _, dimension := range dimensions {
+		standardScore, ok := mStandardScore[dimension.ID]
+		if !ok {
+			continue
+		}
+		dimensionIds = append(dimensionIds, dimension.ID)
+		resp.Dimensions = append(resp.Dimensions, &v1.DimensionResult{
+			DimensionId:   dimension.ID,
+			Name:          dimension.Name,
+			StandardScore: standardScore,
+		})
+	}
+	if resp.Visibility != v1.ResultVisibility_ResultFull {
+		return
+	}
+	// 完整报告附带分数所在区间的评语，区间重叠时取下限最低的一条
+	comments, err := uc.salesPaperUc.GetCommentList(ctx, association.SalesPaperID)
+	if err != nil {
+		return
+	}
+	for _, comment := range comments {
+		if inBand(resp.Score, comment.LowScore, comment.UpScore) {
+			resp.Comment = comment.Content
+			break
+		}
+	}
+	mDimensionComment, err := uc.salesPaperUc.GetDimensionCommentMap(ctx, dimensionIds)
+	if err != nil {
+		return
+	}
+	for _, dimension := range resp.Dimensions {
+		for _, comment := range mDimensionComment[dimension.DimensionId] {
+			if inBand(dimension.StandardScore, comment.LowScore, comment.UpScore) {
+				dimension.Comment = comment.Content
+				break
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"testing"
+
+	"exam_api/internal/data/entity"
+)
+
+func TestCanViewResult(t *testing.T) {
+	association := &entity.ExamineeSalesPaperAssociation{ExamineeID: "EP1", CreatedBy: "A1"}
+	cases := []struct {
+		name      string
+		userId    string
+		recruiter bool
+		want      bool
+	}{
+		{"考生查看自己的考试", "EP1", false, true},
+		{"考生查看他人的考试", "EP2", false, false},
+		{"招聘人员查看自己安排的考试", "A1", true, true},
+		{"招聘人员查看他人安排的考试", "A2", true, false},
+		{"招聘人员不能冒用考生身份", "EP1", true, false},
+	}
+	for _, c := range cases {
+		if got := canViewResult(association, c.userId, c.recruiter); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestInBand(t *testing.T) {
+	cases := []struct {
+		score, low, up float64
+		want           bool
+	}{
+		{50, 40, 60, true},
+		{40, 40, 60, true},
+		{60, 40, 60, true},
+		{39.99, 40, 60, false},
+		{60.01, 40, 60, false},
+	}
+	for _, c := range cases {
+		if got := inBand(c.score, c.low, c.up); got != c.want {
+			t.Errorf("inBand(%v, %v, %v) = %v, want %v", c.score, c.low, c.up, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/data/entity"
+	"exam_api/internal/pkg/icontext"
+	innErr "exam_api/internal/pkg/ierrors"
+	"time"
+)
+
+// 分部锁定原因
+const (
+	SectionLockSubmitted = "submitted" // 考生提交
+	SectionLockTimeout   = "timeout"   // 分部限时用完
+)
+
+// 分部相关的答案校验失败原因
+const (
+	AnswerSectionLocked  = "SECTION_LOCKED"   // 题目所在分部已提交或已超时
+	AnswerSectionNotOpen = "SECTION_NOT_OPEN" // 题目所在分部还未开放
+)
+
+// SectionProgress 一个分部的作答进度（秒级时间戳），按分部id保存在 ExamineeAnswer.SectionState
+type SectionProgress struct {
+	StartedAt  int64  `json:"started_at"`  // 首次进入时间
+	LockedAt   int64  `json:"locked_at"`   // 锁定时间，0表示未锁定
+	LockReason string `json:"lock_reason"` // 锁定原因
+}
+
+// sectionPlan 一次作答的分部安排和进度
+type sectionPlan struct {
+	sections         []*entity.SalesPaperSection
+	mQuestionSection map[string]string           // 题目id -> 分部id
+	progress         map[string]*SectionProgress // 分部id -> 进度
+	raw              string                      // 读取时的状态，保存时用作乐观锁
+}
+
+// current 当前分部：按顺序第一个未锁定的分部，全部锁定时返回 nil
+func (p *sectionPlan) current() *entity.SalesPaperSection {
+	for _, section := range p.sections {
+		if !p.locked(section.ID) {
+			return section
+		}
+	}
+	return nil
+}
+
+func (p *sectionPlan) find(sectionId string) *entity.SalesPaperSection {
+	for _, section := range p.sections {
+		if section.ID == sectionId {
+			return section
+		}
+	}
+	return nil
+}
+
+func (p *sectionPlan) locked(sectionId string) bool {
+	progress, ok := p.progress[sectionId]
+	return ok && progress.LockedAt > 0
+}
+
+func (p *sectionPlan) lock(sectionId, reason string, now time.Time) {
+	progress, ok := p.progress[sectionId]
+	if !ok {
+		progress = &SectionProgress{StartedAt: now.Unix()}
+		p.progress[sectionId] = progress
+	}
+	progress.LockedAt, progress.LockReason = now.Unix(), reason
+}
+
+// checkQuestion 题目所在分部是否允许作答：只有当前分部的题目可以保存答案
+func (p *sectionPlan) checkQuestion(questionId string) string {
+	sectionId, ok := p.mQuestionSection[questionId]
+	if !ok {
+		return ""
+	}
+	if p.locked(sectionId) {
+		return AnswerSectionLocked
+	}
+	if cur := p.current(); cur == nil || cur.ID != sectionId {
+		return AnswerSectionNotOpen
+	}
+	return ""
+}
+
+func (p *sectionPlan) toSectionData(section *entity.SalesPaperSection, now time.Time) *v1.SectionData {
+	res := &v1.SectionData{
+		SectionId:    section.ID,
+		Name:         section.Name,
+		Instructions: section.Instructions,
+		Order:        section.Order_,
+		TimeLimit:    section.TimeLimit * 60,
+		Status:       v1.SectionStatus_SectionNotStarted,
+	}
+	progress, ok := p.progress[section.ID]
+	switch {
+	case !ok:
+	case progress.LockReason == SectionLockTimeout:
+		res.Status = v1.SectionStatus_SectionTimeout
+	case progress.LockedAt > 0:
+		res.Status = v1.SectionStatus_SectionSubmitted
+	default:
+		res.Status = v1.SectionStatus_SectionInProgress
+		if res.TimeLimit > 0 {
+			res.Remaining = max(res.TimeLimit-int32(now.Unix()-progress.StartedAt), 0)
+		}
+	}
+	return res
+}
+
+// loadSectionPlan 读取试卷分部和本次作答的分部进度，试卷不分部时返回 nil。
+// 分部限时用完的自动锁定并保存；未归属分部的维度的题目随第一个分部作答
+func (uc *ExamineeAnswerUseCase) loadSectionPlan(ctx context.Context, examineeAnswer *entity.ExamineeAnswer, now time.Time) (plan *sectionPlan, err error) {
+	sections, err := uc.salesPaperUc.GetSectionList(ctx, examineeAnswer.SalesPaperID)
+	if err != nil || len(sections) == 0 {
+		return
+	}
+	dimensions, err := uc.salesPaperUc.GetDimensionList(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	mQuestionDimension, err := uc.questionUc.GetQuestionDimensionMap(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	plan = &sectionPlan{
+		sections:         sections,
+		mQuestionSection: make(map[string]string, len(mQuestionDimension)),
+		progress:         make(map[string]*SectionProgress),
+		raw:              examineeAnswer.SectionState,
+	}
+	mDimensionSection := make(map[string]string, len(dimensions))
+	for _, dimension := range dimensions {
+		mDimensionSection[dimension.ID] = dimension.SectionID
+		if plan.find(dimension.SectionID) == nil {
+			mDimensionSection[dimension.ID] = sections[0].ID
+		}
+	}
+	for questionId, dimensionId := range mQuestionDimension {
+		if sectionId, ok := mDimensionSection[dimensionId]; ok {
+			plan.mQuestionSection[questionId] = sectionId
+		} else {
+			plan.mQuestionSection[questionId] = sections[0].ID
+		}
+	}
+	if examineeAnswer.SectionState != "" {
+		if err = json.Unmarshal([]byte(examineeAnswer.SectionState), &plan.progress); err != nil {
+			return nil, err
+		}
+	}
+	// 分部限时用完的自动锁定
+	timeout := false
+	for _, section := range sections {
+		progress, ok := plan.progress[section.ID]
+		if !ok || progress.LockedAt > 0 || section.TimeLimit <= 0 {
+			continue
+		}
+		if now.Unix()-progress.StartedAt >= int64(section.TimeLimit)*60 {
+			plan.lock(section.ID, SectionLockTimeout, now)
+			timeout = true
+		}
+	}
+	if timeout {
+		err = uc.saveSectionPlan(ctx, examineeAnswer, plan)
+	}
+	return
+}
+
+// saveSectionPlan 保存分部进度，期间状态被其他请求修改时返回错误
+func (uc *ExamineeAnswerUseCase) saveSectionPlan(ctx context.Context, examineeAnswer *entity.ExamineeAnswer, plan *sectionPlan) error {
+	value, _ := json.Marshal(plan.progress)
+	rowsAffected, err := uc.repo.UpdateSectionState(ctx, examineeAnswer.ID, string(value), plan.raw)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("系统繁忙，请稍后重试")
+	}
+	plan.raw = string(value)
+	examineeAnswer.SectionState = plan.raw
+	return nil
+}
+
+// enterSection 进入分部：不指定时进入当前分部；只能进入当前分部，已锁定的分部不能再进入。首次进入时开始计时
+func (uc *ExamineeAnswerUseCase) enterSection(ctx context.Context, examineeAnswer *entity.ExamineeAnswer, plan *sectionPlan, sectionId string, now time.Time) (section *entity.SalesPaperSection, err error) {
+	cur := plan.current()
+	if sectionId == "" {
+		if cur == nil {
+			err = innErr.ErrSectionLocked
+			return
+		}
+		sectionId = cur.ID
+	}
+	if section = plan.find(sectionId); section == nil {
+		err = errors.New("分部不存在")
+		return
+	}
+	if plan.locked(sectionId) {
+		err = innErr.ErrSectionLocked
+		return
+	}
+	if cur == nil || cur.ID != sectionId {
+		err = innErr.ErrSectionNotOpen
+		return
+	}
+	if _, ok := plan.progress[sectionId]; !ok {
+		plan.progress[sectionId] = &SectionProgress{StartedAt: now.Unix()}
+		if err = uc.saveSectionPlan(ctx, examineeAnswer, plan); err != nil {
+			uc.log.WithContext(ctx).Errorf("enterSection.saveSectionPlan Failed, sectionId:%v, err:%v", sectionId, err.Error())
+			err = innErr.ErrInternalServer
+		}
+	}
+	return
+}
+
+// SubmitSection 提交当前分部，提交后不能再进入，返回下一个分部
+func (uc *ExamineeAnswerUseCase) SubmitSection(ctx context.Context, req *v1.SubmitSectionRequest) (resp *v1.SubmitSectionResponse, err error) {
+	resp = &v1.SubmitSectionResponse{}
+	var (
+		l                = uc.log.WithContext(ctx)
+		associationId, _ = icontext.AssociationIdFrom(ctx)
+		now              = time.Now()
+	)
+	association, err := uc.associationUc.GetById(ctx, associationId)
+	if err != nil {
+		l.Errorf("SubmitSection.associationUc.GetById Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	examineeAnswer, err := uc.repo.GetByAssociationId(ctx, associationId)
+	if err != nil {
+		l.Errorf("SubmitSection.repo.GetByAssociationId Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if association == nil || examineeAnswer == nil {
+		err = errors.New("考试记录不存在")
+		return
+	}
+	// 交卷或过期后分部状态不能再改变
+	if !attemptInProgress(association, examineeAnswer) {
+		err = errors.New("考试状态异常")
+		return
+	}
+	plan, err := uc.loadSectionPlan(ctx, examineeAnswer, now)
+	if err != nil {
+		l.Errorf("SubmitSection.loadSectionPlan Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if plan == nil {
+		err = errors.New("试卷未分部")
+		return
+	}
+	if plan.find(req.SectionId) == nil {
+		err = errors.New("分部不存在")
+		return
+	}
+	if plan.locked(req.SectionId) {
+		err = innErr.ErrSectionLocked
+		return
+	}
+	if cur := plan.current(); cur == nil || cur.ID != req.SectionId {
+		err = innErr.ErrSectionNotOpen
+		return
+	}
+	plan.lock(req.SectionId, SectionLockSubmitted, now)
+	if err = uc.saveSectionPlan(ctx, examineeAnswer, plan); err != nil {
+		l.Errorf("SubmitSection.saveSectionPlan Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if next := plan.current(); next != nil {
+		resp.NextSectionId = next.ID
+	}
+	return
+}
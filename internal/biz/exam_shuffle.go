@@ -0,0 +1,98 @@
+package biz
+
+import (
+	v1 "exam_api/api/exam_api/v1"
+	"hash/fnv"
+	"math/rand/v2"
+	"sort"
+)
+
+// shuffleRand 由作答id和用途派生随机数，同一作答每次得到相同的排列，刷新页面顺序不变
+func shuffleRand(examineeAnswerId, salt string) *rand.Rand {
+	h1 := fnv.New64a()
+	h1.Write([]byte(examineeAnswerId))
+	h2 := fnv.New64a()
+	h2.Write([]byte(salt))
+	return rand.New(rand.NewPCG(h1.Sum64(), h2.Sum64()))
+}
+
+// optionOrder 返回题目选项的展示顺序：第 i 个展示位置显示原顺序中的第 order[i] 个选项
+func optionOrder(examineeAnswerId, questionId string, n int) []int {
+	return shuffleRand(examineeAnswerId, "option:"+questionId).Perm(n)
+}
+
+// shuffleQuestions 按作答打乱题目和（或）选项顺序，返回新的题目列表，不修改传入的（缓存）数据。
+// 打乱后题目沿用原有的序号集合按展示位置重新编号，选项沿用原有的字母按展示位置重新编号
+func shuffleQuestions(questions []*v1.QuestionData, examineeAnswerId string, shuffleQuestion, shuffleOption bool) []*v1.QuestionData {
+	res := make([]*v1.QuestionData, 0, len(questions))
+	for _, question := range questions {
+		cur := &v1.QuestionData{
+			QuestionId:          question.QuestionId,
+			Title:               question.Title,
+			QuestionTypeId:      question.QuestionTypeId,
+			Order:               question.Order,
+			QuestionOptionsData: question.QuestionOptionsData,
+			Attachments:         question.Attachments,
+		}
+		if shuffleOption {
+			order := optionOrder(examineeAnswerId, question.QuestionId, len(question.QuestionOptionsData))
+			cur.QuestionOptionsData = make([]*v1.QuestionOptionData, 0, len(order))
+			for i, j := range order {
+				option := question.QuestionOptionsData[j]
+				cur.QuestionOptionsData = append(cur.QuestionOptionsData, &v1.QuestionOptionData{
+					QuestionOptionId: option.QuestionOptionId,
+					Description:      option.Description,
+					SerialNumber:     question.QuestionOptionsData[i].SerialNumber,
+					Attachments:      option.Attachments,
+				})
+			}
+		}
+		res = append(res, cur)
+	}
+	if shuffleQuestion {
+		orders := make([]int32, 0, len(res))
+		for _, question := range res {
+			orders = append(orders, question.Order)
+		}
+		sort.Slice(orders, func(i, j int) bool { return orders[i] < orders[j] })
+		shuffleRand(examineeAnswerId, "question").Shuffle(len(res), func(i, j int) {
+			res[i], res[j] = res[j], res[i]
+		})
+		for i, question := range res {
+			question.Order = orders[i]
+		}
+	}
+	return res
+}
+
+// toCanonicalLetters 将考生看到的选项字母换回原顺序的字母，保存答案和算分都使用原顺序。
+// 不存在的字母原样返回，由答案校验处理
+func toCanonicalLetters(question *v1.QuestionData, examineeAnswerId string, letters []string) []string {
+	order := optionOrder(examineeAnswerId, question.QuestionId, len(question.QuestionOptionsData))
+	mLetter := make(map[string]string, len(order))
+	for i, j := range order {
+		mLetter[question.QuestionOptionsData[i].SerialNumber] = question.QuestionOptionsData[j].SerialNumber
+	}
+	return mapLetters(mLetter, letters)
+}
+
+// toDisplayLetters 将原顺序的选项字母换成考生看到的字母，用于回显已保存的答案
+func toDisplayLetters(question *v1.QuestionData, examineeAnswerId string, letters []string) []string {
+	order := optionOrder(examineeAnswerId, question.QuestionId, len(question.QuestionOptionsData))
+	mLetter := make(map[string]string, len(order))
+	for i, j := range order {
+		mLetter[question.QuestionOptionsData[j].SerialNumber] = question.QuestionOptionsData[i].SerialNumber
+	}
+	return mapLetters(mLetter, letters)
+}
+
+func mapLetters(mLetter map[string]string, letters []string) []string {
+	res := make([]string, 0, len(letters))
+	for _, letter := range letters {
+		if v, ok := mLetter[letter]; ok {
+			letter = v
+		}
+		res = append(res, letter)
+	}
+	return res
+}
@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"slices"
+	"testing"
+
+	v1 "exam_api/api/exam_api/v1"
+)
+
+func shuffleTestQuestions() []*v1.QuestionData {
+	questions := make([]*v1.QuestionData, 0, 5)
+	for i, id := range []string{"Q1", "Q2", "Q3", "Q4", "Q5"} {
+		options := make([]*v1.QuestionOptionData, 0, 5)
+		for j, letter := range []string{"A", "B", "C", "D", "E"} {
+			options = append(options, &v1.QuestionOptionData{QuestionOptionId: id + "-" + letter, SerialNumber: letter, Description: string(rune('a' + j))})
+		}
+		questions = append(questions, &v1.QuestionData{QuestionId: id, Order: int32(i + 1), QuestionOptionsData: options})
+	}
+	return questions
+}
+
+func TestShuffleQuestions(t *testing.T) {
+	questions := shuffleTestQuestions()
+	cases := []struct {
+		name                           string
+		shuffleQuestion, shuffleOption bool
+	}{
+		{"不打乱", false, false},
+		{"只打乱题目", true, false},
+		{"只打乱选项", false, true},
+		{"题目和选项都打乱", true, true},
+	}
+	for _, c := range cases {
+		got := shuffleQuestions(questions, "EA1", c.shuffleQuestion, c.shuffleOption)
+		again := shuffleQuestions(questions, "EA1", c.shuffleQuestion, c.shuffleOption)
+		ids, orders := make([]string, 0), make([]int32, 0)
+		for i, question := range got {
+			ids = append(ids, question.QuestionId)
+			orders = append(orders, question.Order)
+			if again[i].QuestionId != question.QuestionId {
+				t.Errorf("%s: 同一作答两次顺序不同", c.name)
+			}
+			// 选项字母按展示位置重新编号，仍为 A~E
+			letters := make([]string, 0)
+			for j, option := range question.QuestionOptionsData {
+				letters = append(letters, option.SerialNumber)
+				if again[i].QuestionOptionsData[j].QuestionOptionId != option.QuestionOptionId {
+					t.Errorf("%s: 同一作答两次选项顺序不同", c.name)
+				}
+			}
+			if !slices.Equal(letters, []string{"A", "B", "C", "D", "E"}) {
+				t.Errorf("%s: letters = %v", c.name, letters)
+			}
+		}
+		if !slices.Equal(orders, []int32{1, 2, 3, 4, 5}) {
+			t.Errorf("%s: orders = %v", c.name, orders)
+		}
+		if !c.shuffleQuestion && !slices.Equal(ids, []string{"Q1", "Q2", "Q3", "Q4", "Q5"}) {
+			t.Errorf("%s: ids = %v", c.name, ids)
+		}
+	}
+	// 不修改传入的（缓存）数据
+	for i, question := range questions {
+		if question.Order != int32(i+1) || question.QuestionOptionsData[0].SerialNumber != "A" || question.QuestionOptionsData[0].QuestionOptionId != question.QuestionId+"-A" {
+			t.Fatalf("shuffleQuestions modified the input: %v", question)
+		}
+	}
+}
+
+func TestLetterMapping(t *testing.T) {
+	questions := shuffleTestQuestions()
+	shuffled := shuffleQuestions(questions, "EA1", false, true)
+	for i, question := range questions {
+		display := shuffled[i]
+		for _, option := range display.QuestionOptionsData {
+			// 考生看到的字母换回原顺序后，指向同一个选项
+			canonical := toCanonicalLetters(question, "EA1", []string{option.SerialNumber})
+			original := question.QuestionOptionsData[canonical[0][0]-'A']
+			if original.QuestionOptionId != option.QuestionOptionId {
+				t.Errorf("%s: %s -> %s points to %s, want %s", question.QuestionId, option.SerialNumber, canonical[0], original.QuestionOptionId, option.QuestionOptionId)
+			}
+		}
+	}
+	cases := []struct {
+		name    string
+		letters []string
+	}{
+		{"单个字母", []string{"A"}},
+		{"多个字母保持顺序", []string{"C", "A", "E"}},
+		{"空答案", []string{}},
+	}
+	for _, question := range questions {
+		for _, c := range cases {
+			canonical := toCanonicalLetters(question, "EA1", c.letters)
+			if back := toDisplayLetters(question, "EA1", canonical); !slices.Equal(back, c.letters) {
+				t.Errorf("%s %s: display -> canonical -> display = %v, want %v", question.QuestionId, c.name, back, c.letters)
+			}
+		}
+	}
+	// 不存在的字母原样返回，由答案校验处理
+	if got := toCanonicalLetters(questions[0], "EA1", []string{"Z"}); !slices.Equal(got, []string{"Z"}) {
+		t.Errorf("unknown letter = %v, want [Z]", got)
+	}
+	// 不同作答的排列相互独立
+	same := true
+	for i := range questions {
+		a := shuffleQuestions(questions, "EA1", false, true)[i].QuestionOptionsData
+		b := shuffleQuestions(questions, "EA2", false, true)[i].QuestionOptionsData
+		for j := range a {
+			if a[j].QuestionOptionId != b[j].QuestionOptionId {
+				same = false
+			}
+		}
+	}
+	if same {
+		t.Error("different attempts got the same option order")
+	}
+}
@@ -10,30 +10,65 @@ import (
 	"exam_api/internal/middleware"
 	"exam_api/internal/pkg/icontext"
 	innErr "exam_api/internal/pkg/ierrors"
+	"exam_api/internal/pkg/ijwt"
 	"exam_api/internal/pkg/isnowflake"
 	"exam_api/internal/pkg/itask"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
 	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type ExamineeAnswerRepo interface {
 	GetByAssociationId(ctx context.Context, associationId string) (resEntity *entity.ExamineeAnswer, err error)
+	GetById(ctx context.Context, id string) (resEntity *entity.ExamineeAnswer, err error)
 	GetByIDs(ctx context.Context, examineeId string) (list []*entity.ExamineeAnswer, err error)
 	Create(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) error
-	UpdateAction(ctx context.Context, examineeAnswerId string, lastActionTime, lastActionTime2 time.Time, remaining int32, completeQuestionNum int32) (int64, error)
+	Delete(ctx context.Context, examineeAnswerId string) error
+	UpdateAction(ctx context.Context, examineeAnswerId string, lastActionTime, lastActionTime2 time.Time, remaining int32) (int64, error)
+	UpdateCompleteQuestionNum(ctx context.Context, examineeAnswerId string, completeQuestionNum int32) error
 	UpdateResult(ctx context.Context, examineeAnswerId string, score float64, comparability, usability int32) error
-	SubmitResult(ctx context.Context, examineeAnswerId string, score float64, comparability, usability int32) error
+	UpdateIntegrity(ctx context.Context, examineeAnswerId string, integrityScore float64, integrityFlags string) error
+	UpdateSectionState(ctx context.Context, examineeAnswerId string, sectionState, oldSectionState string) (int64, error)
+	UpdateAdaptiveState(ctx context.Context, examineeAnswerId string, adaptiveState, questionIds, oldAdaptiveState string) (int64, error)
+	SubmitResult(ctx context.Context, examineeAnswerId string, submitTime time.Time, remaining int32, completeQuestionNum int32) error
+	GetOverdueList(ctx context.Context, now time.Time, after *entity.ExamineeAnswer, limit int) (list []*entity.ExamineeAnswer, err error)
 }
 
+const (
+	// sweepBatchSize 扫描时每页处理的考试数量
+	sweepBatchSize = 100
+	// rescoreDelay 已提交超过该时长仍未算完分（异步算分丢失），或处理失败超过该时长的考试由扫描重新算分
+	rescoreDelay = 5 * time.Minute
+	// maxRescoreTimes 每场考试最多由扫描重新算分的次数，超过后停留在处理失败阶段，需要人工排查
+	maxRescoreTimes = 5
+	// heartbeatChargeCap 两次心跳之间最多计入作答用时的秒数，考生短暂断线不计时；断线超过剩余时长时按 DueAt 判定时间用完
+	heartbeatChargeCap = 30
+	// activeSessionIdle 有效会话的空闲过期时间，超过该时间没有请求视为会话已失效，可重新进入考试
+	activeSessionIdle = 5 * time.Minute
+	// 浏览器事件上报限制：单次最多条数，每个会话每分钟最多上报次数，每条事件附加信息的最多项数和最大字节数
+	reportEventBatchSize = 100
+	reportEventRateLimit = 30
+	reportEventMetaKeys  = 20
+	reportEventMetaBytes = 2048
+	// fingerprintEventExpire 指纹变化去重标记的有效期，覆盖一次作答的最长时间
+	fingerprintEventExpire = 24 * time.Hour
+)
+
 type ExamineeAnswerUseCase struct {
 	repo                     ExamineeAnswerRepo
 	associationUc            *ExamineeSalesPaperAssociationUseCase
 	salesPaperUc             *SalesPaperUseCase
 	examineeQuestionAnswerUC *ExamineeQuestionAnswerUseCase
 	examEvent                *ExamEventUseCase
+	scoreUc                  *ExamineeAnswerScoreUseCase
+	questionUc               *QuestionUseCase
 	redisRepo                RedisRepository
+	storage                  StorageRepo
 	log                      *log.Helper
 }
 
@@ -42,7 +77,10 @@ func NewExamineeAnswerUseCase(repo ExamineeAnswerRepo,
 	salesPaperUc *SalesPaperUseCase,
 	examineeQuestionAnswerUC *ExamineeQuestionAnswerUseCase,
 	examEvent *ExamEventUseCase,
+	scoreUc *ExamineeAnswerScoreUseCase,
+	questionUc *QuestionUseCase,
 	redisRepo RedisRepository,
+	storage StorageRepo,
 	logger log.Logger) *ExamineeAnswerUseCase {
 	return &ExamineeAnswerUseCase{
 		repo:                     repo,
@@ -50,7 +88,10 @@ func NewExamineeAnswerUseCase(repo ExamineeAnswerRepo,
 		salesPaperUc:             salesPaperUc,
 		examineeQuestionAnswerUC: examineeQuestionAnswerUC,
 		examEvent:                examEvent,
+		scoreUc:                  scoreUc,
+		questionUc:               questionUc,
 		redisRepo:                redisRepo,
+		storage:                  storage,
 		log:                      log.NewHelper(logger)}
 }
 
@@ -87,15 +128,57 @@ func (uc *ExamineeAnswerUseCase) StartExam(ctx context.Context, req *v1.StartExa
 		err = innErr.ErrInternalServer
 		return
 	}
+	// 练习卷可以重新开始：主动重新开始、已交卷或已过截止时间时丢弃上一次练习，重新作答
+	if req.Restart && !salesPaper.IsPractice {
+		err = errors.New("只有练习卷可以重新开始")
+		return
+	}
+	if salesPaper.IsPractice && examineeAnswer != nil && (req.Restart || examineeAnswer.SubmitTime != nil || time.Now().After(examineeAnswer.Deadline)) {
+		if err = uc.discardPractice(ctx, examineeAnswer); err != nil {
+			l.Errorf("StartExam.discardPractice Failed, req:%v, err:%v", req, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		examineeAnswer = nil
+	}
+	timeLimit := uc.associationUc.GetTimeLimit(association, salesPaper)
 	if examineeAnswer == nil {
+		//第一次进入考试需要在开放窗口内，练习不受开放窗口限制
+		curTime := time.Now()
+		if !salesPaper.IsPractice {
+			if err = uc.associationUc.CheckWindow(association, curTime); err != nil {
+				return
+			}
+		}
 		id, e := isnowflake.SnowFlake.NextID(_const.ExamineeAnswerPrefix)
 		if e != nil {
 			l.Errorf("StartExam.isnowflake.SnowFlake.NextID Failed, req:%v, err:%v", req, e.Error())
 			err = innErr.ErrInternalServer
 			return
 		}
-		//第一次进入考试
-		curTime := time.Now()
+		// 试卷配置了抽题规则时，按规则抽题并固定在本次作答上；自适应试卷从空题目集开始，逐题下发
+		questionIds := ""
+		rules, e := uc.salesPaperUc.GetDrawRules(ctx, association.SalesPaperID)
+		if e != nil {
+			err = e
+			return
+		}
+		if salesPaper.Adaptive {
+			questionIds = "[]"
+		} else if len(rules) > 0 {
+			drawn, e := uc.questionUc.DrawQuestions(ctx, association.SalesPaperID, rules, id)
+			if e != nil {
+				err = e
+				return
+			}
+			value, _ := json.Marshal(drawn)
+			questionIds = string(value)
+		}
+		// 截止时间为关闭时间，未设置时默认3天；练习不受开放窗口限制，截止时间从本次开始计算
+		deadline := curTime.AddDate(0, 0, 3)
+		if association.CloseAt != nil && !salesPaper.IsPractice {
+			deadline = *association.CloseAt
+		}
 		examineeAnswer = &entity.ExamineeAnswer{
 			ID:                              id,
 			SalesPaperID:                    association.SalesPaperID,
@@ -107,9 +190,12 @@ func (uc *ExamineeAnswerUseCase) StartExam(ctx context.Context, req *v1.StartExa
 			SubmitTime:                      nil,
 			CompleteQuestionNum:             0,
 			Comparability:                   0,
-			Deadline:                        curTime.AddDate(0, 0, 3),
+			Deadline:                        deadline,
 			Usability:                       0,
-			RemainingTimelimit:              salesPaper.RecommendTimeLim * 60,
+			IsPractice:                      salesPaper.IsPractice,
+			QuestionIds:                     questionIds,
+			RemainingTimelimit:              timeLimit * 60,
+			DueAt:                           curTime.Add(time.Duration(timeLimit) * time.Minute),
 			CreatedBy:                       userId,
 		}
 		e = uc.repo.Create(ctx, examineeAnswer)
@@ -118,33 +204,92 @@ func (uc *ExamineeAnswerUseCase) StartExam(ctx context.Context, req *v1.StartExa
 			err = innErr.ErrInternalServer
 			return
 		}
-		// 将状态更新成进行中
-		e = uc.associationUc.UpdateStageNumber(ctx, req.ExamineeAssociationId, v1.StageNumber_InProgress)
-		if e != nil {
-			l.Errorf("StartExam.associationUc.UpdateStageNumber Failed, req:%v, stage:%v, err:%v", req, v1.StageNumber_InProgress, e.Error())
-			err = innErr.ErrInternalServer
-			return
+		// 将状态更新成进行中，练习不改变考试状态
+		if !examineeAnswer.IsPractice {
+			e = uc.associationUc.UpdateStageNumber(ctx, req.ExamineeAssociationId, v1.StageNumber_InProgress)
+			if e != nil {
+				l.Errorf("StartExam.associationUc.UpdateStageNumber Failed, req:%v, stage:%v, err:%v", req, v1.StageNumber_InProgress, e.Error())
+				err = innErr.ErrInternalServer
+				return
+			}
 		}
 	}
 	if examineeAnswer.Deadline.Sub(time.Now()) < 0 {
-		//已经过期， 设置状态
-		err = uc.associationUc.UpdateStageNumber(ctx, req.ExamineeAssociationId, v1.StageNumber_Expire)
-		if err != nil {
-			l.Errorf("StartExam.associationUc.UpdateStageNumber Failed, req:%v, stage:%v, err:%v", req, v1.StageNumber_Expire, err.Error())
+		//已经过期， 设置状态，练习不改变考试状态
+		if !examineeAnswer.IsPractice {
+			err = uc.associationUc.UpdateStageNumber(ctx, req.ExamineeAssociationId, v1.StageNumber_Expire)
+			if err != nil {
+				l.Errorf("StartExam.associationUc.UpdateStageNumber Failed, req:%v, stage:%v, err:%v", req, v1.StageNumber_Expire, err.Error())
+			}
 		}
-		err = errors.New("该考试已过截止时间")
+		err = innErr.ErrExamExpired
 		return
 	}
 	clientInfo, _ := icontext.UserClientFrom(ctx)
 	// 生成jwt
-	examJWT, _, err := middleware.JWT.GenerateExamToken(accessToken, association.ID, time.Duration(examineeAnswer.RemainingTimelimit*60)*time.Second, clientInfo)
+	examJWT, examClaims, err := middleware.JWT.GenerateExamToken(accessToken, association.ID, time.Duration(examineeAnswer.RemainingTimelimit*60)*time.Second, clientInfo)
 	if err != nil {
 		// 处理错误
 		err = errors.New("进入考试失败，请重试")
 		return
 	}
+	// 同一场考试只允许一个有效会话。试卷设置为不踢掉旧会话时，旧会话仍在线则拒绝进入，
+	// 但同一客户端（指纹一致，如刷新页面）可以接管自己的会话
+	sessionKey := fmt.Sprintf(_const.RedisActiveSessionKey, association.ID)
+	latestKey := fmt.Sprintf(_const.RedisLatestSessionKey, association.ID)
+	oldSessionId, err := uc.redisRepo.Get(ctx, sessionKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		l.Errorf("StartExam.redisRepo.Get Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	latest, err := uc.redisRepo.Get(ctx, latestKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		l.Errorf("StartExam.redisRepo.Get Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if _, latestFP := parseLatestSession(latest); oldSessionId != "" && !salesPaper.KickOldSession && latestFP != examClaims.ClientFP {
+		err = innErr.ErrExamSessionActive
+		return
+	}
+	// 只有最近签发的会话可以在空闲过期后重新上线，被顶替的会话不能再接管
+	err = uc.redisRepo.Set(ctx, latestKey, latestSessionValue(examClaims.SessionID, examClaims.ClientFP), time.Until(time.Unix(examClaims.ExpiresAt, 0)))
+	if err != nil {
+		l.Errorf("StartExam.redisRepo.Set Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	err = uc.redisRepo.Set(ctx, sessionKey, examClaims.SessionID, activeSessionIdle)
+	if err != nil {
+		l.Errorf("StartExam.redisRepo.Set Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if oldSessionId != "" {
+		// 记录顶替事件
+		eventCtx := icontext.WithSessionIdKey(ctx, examClaims.SessionID)
+		examineeAnswerId := examineeAnswer.ID
+		go itask.TaskWithContext(eventCtx, func() {
+			meta := map[string]interface{}{
+				"old_session_id": oldSessionId,
+				"new_session_id": examClaims.SessionID,
+			}
+			if e := uc.examEvent.ExamEvent(eventCtx, examineeAnswerId, _const.ExamEventTakeover, meta); e != nil {
+				l.Errorf("StartExam.examEvent.ExamEvent Failed, req:%v, err:%v", req, e.Error())
+			}
+		}, l)
+	}
+	answered, total, _, err := uc.answerProgress(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("StartExam.answerProgress Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
 	resp.ExamToken = examJWT
-	resp.TotalDuration = salesPaper.RecommendTimeLim * 60
+	resp.AnsweredCount = answered
+	resp.TotalCount = total
+	resp.TotalDuration = timeLimit * 60
 	resp.Remaining = examineeAnswer.RemainingTimelimit
 	resp.UsedDuration = resp.TotalDuration - resp.Remaining
 	return
@@ -154,7 +299,6 @@ func (uc *ExamineeAnswerUseCase) HeartbeatAndSave(ctx context.Context, req *v1.H
 	resp = &v1.HeartbeatAndSaveResponse{}
 	var (
 		l                = uc.log.WithContext(ctx)
-		userId, _        = icontext.UserIdFrom(ctx)
 		associationId, _ = icontext.AssociationIdFrom(ctx)
 	)
 	// 1. 获取答题信息
@@ -164,8 +308,22 @@ func (uc *ExamineeAnswerUseCase) HeartbeatAndSave(ctx context.Context, req *v1.H
 		err = innErr.ErrInternalServer
 		return
 	}
+	if examineeAnswer == nil {
+		err = errors.New("考试记录不存在")
+		return
+	}
+	// 校验答案，不合法的答案不保存
+	answerData, invalid, err := uc.validateAnswers(ctx, examineeAnswer, req.AnswerData)
+	if err != nil {
+		l.Errorf("HeartbeatAndSave.validateAnswers Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if len(invalid) > 0 {
+		err = innErr.ErrInvalidAnswer.WithMetadata(invalid)
+		return
+	}
 	// 2. 防止频繁心跳
-	thisDuration := 0.0
 	activeTime := time.Now()
 	if !examineeAnswer.LastActionTime.IsZero() {
 		gap := activeTime.Sub(examineeAnswer.LastActionTime)
@@ -191,18 +349,21 @@ func (uc *ExamineeAnswerUseCase) HeartbeatAndSave(ctx context.Context, req *v1.H
 				}
 			}, l)
 		}
-		// 计算本次耗时（最多算 30 秒）
-		thisDuration = math.Min(elapsed, 30)
 	}
 	// 4. 检查考试时间是否已经用完
-	limit := examineeAnswer.RemainingTimelimit - int32(thisDuration)
+	limit := remainingSeconds(examineeAnswer, activeTime)
 	if limit <= 0 {
-		// todo 自动提交
+		// 时间已用完，按交卷流程自动提交（保存本次答案）
+		err = uc.submit(ctx, associationId, req.AnswerData, _const.ExamEventTimeUp)
+		if err != nil {
+			l.Errorf("HeartbeatAndSave.submit Failed, req:%v, err:%v", req, err.Error())
+			return
+		}
 		resp.Remaining = 0
 		return
 	}
 	// 5. 更新最后活跃时间和剩余时间(乐观锁)
-	rowsAffected, err := uc.repo.UpdateAction(ctx, examineeAnswer.ID, activeTime, examineeAnswer.LastActionTime, limit, int32(len(req.AnswerData)))
+	rowsAffected, err := uc.repo.UpdateAction(ctx, examineeAnswer.ID, activeTime, examineeAnswer.LastActionTime, limit)
 	if err != nil {
 		l.Errorf("HeartbeatAndSave.repo.UpdateAction Failed, req:%v, err:%v", req, err.Error())
 		err = nil
@@ -219,27 +380,24 @@ func (uc *ExamineeAnswerUseCase) HeartbeatAndSave(ctx context.Context, req *v1.H
 		limit = examineeAnswer.RemainingTimelimit
 	}
 	// 7. 保存答案
-	if len(req.AnswerData) > 0 {
-		answers := make([]*entity.ExamineeAnswerQuestionAnswer, 0, len(req.AnswerData))
-		for _, questionAnswerData := range req.AnswerData {
-			id, _ := isnowflake.SnowFlake.NextID(_const.ExamineeAnswerQuestionAnswerPrefix)
-			sign, _ := json.Marshal(questionAnswerData.OptionsSerialNumberData)
-			answers = append(answers, &entity.ExamineeAnswerQuestionAnswer{
-				ID:               id,
-				ExamineeAnswerID: examineeAnswer.ID,
-				QuestionID:       questionAnswerData.QuestionId,
-				Score:            0,
-				OptionSign:       string(sign),
-				CreatedBy:        userId,
-				UpdatedBy:        userId,
-			})
-		}
-		err = uc.examineeQuestionAnswerUC.SaveAnswer(ctx, answers)
-		if err != nil {
-			l.Errorf("HeartbeatAndSave.examineeQuestionAnswerUC.SaveAnswer Failed, req:%v, err:%v", req, err.Error())
-			err = innErr.ErrInternalServer
-			return
-		}
+	err = uc.saveAnswers(ctx, examineeAnswer.ID, answerData)
+	if err != nil {
+		l.Errorf("HeartbeatAndSave.saveAnswers Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	// 按已保存的答案统计作答进度
+	answered, total, _, err := uc.answerProgress(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("HeartbeatAndSave.answerProgress Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	err = uc.repo.UpdateCompleteQuestionNum(ctx, examineeAnswer.ID, answered)
+	if err != nil {
+		l.Errorf("HeartbeatAndSave.repo.UpdateCompleteQuestionNum Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
 	}
 	// 8. 记录心跳事件
 	go itask.TaskWithContext(ctx, func() {
@@ -248,20 +406,26 @@ func (uc *ExamineeAnswerUseCase) HeartbeatAndSave(ctx context.Context, req *v1.H
 		}
 	}, l)
 	resp.Remaining = limit
+	resp.AnsweredCount = answered
+	resp.TotalCount = total
 	return
 }
 
 func (uc *ExamineeAnswerUseCase) SubmitExam(ctx context.Context, req *v1.SubmitExamRequest) (resp *v1.SubmitExamResponse, err error) {
 	resp = &v1.SubmitExamResponse{}
+	associationId, _ := icontext.AssociationIdFrom(ctx)
+	err = uc.submit(ctx, associationId, req.AnswerData, _const.ExamEventSubmit)
+	return
+}
+
+// submit 交卷：保存最后一次答案、记录提交时间、更新为已提交并记录事件，主动提交和时间用完自动提交共用
+func (uc *ExamineeAnswerUseCase) submit(ctx context.Context, associationId string, answerData []*v1.QuestionAnswerData, eventType _const.ExamEventType) (err error) {
 	var (
-		l                = uc.log.WithContext(ctx)
-		userId, _        = icontext.UserIdFrom(ctx)
-		associationId, _ = icontext.AssociationIdFrom(ctx)
-		thisDuration     = 0.0
-		activeTime       = time.Now()
-		lockExpire       = 5 * time.Second // 锁过期时间（防死锁）
-		lockValue, _     = isnowflake.SnowFlake.NextID("lock")
-		submitKeyExpire  = 4 * time.Hour // 提交标记过期时间
+		l               = uc.log.WithContext(ctx)
+		activeTime      = time.Now()
+		lockExpire      = 5 * time.Second // 锁过期时间（防死锁）
+		lockValue, _    = isnowflake.SnowFlake.NextID("lock")
+		submitKeyExpire = 4 * time.Hour // 提交标记过期时间
 	)
 	lockKey := fmt.Sprintf(_const.RedisLockKey, associationId)
 	submitKey := fmt.Sprintf(_const.RedisSubmitKey, associationId)
@@ -275,7 +439,7 @@ func (uc *ExamineeAnswerUseCase) SubmitExam(ctx context.Context, req *v1.SubmitE
 	// 3. 获取分布式锁（5s 过期）
 	ok, err := uc.redisRepo.SetNX(ctx, lockKey, lockValue, lockExpire)
 	if err != nil {
-		l.Errorf("SubmitExam.redisRepo.SetNX Failed, req:%v, err:%v", req, err.Error())
+		l.Errorf("submit.redisRepo.SetNX Failed, associationId:%v, err:%v", associationId, err.Error())
 		err = innErr.ErrInternalServer
 		return
 	}
@@ -296,88 +460,531 @@ func (uc *ExamineeAnswerUseCase) SubmitExam(ctx context.Context, req *v1.SubmitE
 	}()
 	examineeAnswer, err := uc.repo.GetByAssociationId(ctx, associationId)
 	if err != nil {
-		l.Errorf("SubmitExam.repo.GetByAssociationId Failed, associationId:%v, err:%v ", associationId, err.Error())
+		l.Errorf("submit.repo.GetByAssociationId Failed, associationId:%v, err:%v ", associationId, err.Error())
 		err = innErr.ErrInternalServer
 		return
 	}
 	// 5. 第二重校验：查询数据库最新状态（防并发）
 	association, err := uc.associationUc.GetById(ctx, associationId)
 	if err != nil {
-		l.Errorf("SubmitExam.associationUc.GetById Failed, associationId:%v, err:%v ", associationId, err.Error())
+		l.Errorf("submit.associationUc.GetById Failed, associationId:%v, err:%v ", associationId, err.Error())
 		err = innErr.ErrInternalServer
 		return
 	}
-	if association == nil || association.StageNumber != 1 {
+	if association == nil || examineeAnswer == nil {
 		err = errors.New("考试记录不存在")
 		return
 	}
-	if association.StageNumber == int32(v1.StageNumber_Submit) {
+	if association.StageNumber == int32(v1.StageNumber_Submit) || examineeAnswer.SubmitTime != nil {
 		err = errors.New("试卷已提交，请勿重复操作")
 		return
 	}
-	if association.StageNumber != int32(v1.StageNumber_InProgress) {
+	if !attemptInProgress(association, examineeAnswer) {
 		err = errors.New("考试状态异常")
 		return
 	}
-	// 6. 更新最后活跃时间和作答题目数量
-	if !examineeAnswer.LastActionTime.IsZero() {
-		elapsed := activeTime.Sub(examineeAnswer.LastActionTime).Seconds()
-		// 计算本次耗时（最多算 30 秒）
-		thisDuration = math.Min(elapsed, 30)
-	}
-	// 7. 检查考试时间是否已经用完
-	limit := examineeAnswer.RemainingTimelimit - int32(thisDuration)
-	if limit <= 0 {
+	// 6、7. 扣除本次耗时，检查考试时间是否已经用完
+	limit := remainingSeconds(examineeAnswer, activeTime)
+	if limit <= 0 || eventType == _const.ExamEventTimeUp {
 		limit = 0
 	}
-	// 8. 更新最后活跃时间和剩余时间
-	_, err = uc.repo.UpdateAction(ctx, examineeAnswer.ID, activeTime, examineeAnswer.LastActionTime, limit, int32(len(req.AnswerData)))
+	// 8. 校验并保存答案：主动交卷时答案不合法直接返回；自动交卷无法让考生修改，只丢弃不合法的答案。
+	// 已锁定或未开放分部、已计入能力估计的自适应题目的答案无法再修改，交卷时也只丢弃
+	answerData, invalid, err := uc.validateAnswers(ctx, examineeAnswer, answerData)
 	if err != nil {
-		l.Errorf("HeartbeatAndSave.repo.UpdateAction Failed, req:%v, err:%v", req, err.Error())
+		l.Errorf("submit.validateAnswers Failed, associationId:%v, err:%v", associationId, err.Error())
 		err = innErr.ErrInternalServer
 		return
 	}
-	// 9. 保存答案，更新状态
-	if len(req.AnswerData) > 0 {
-		answers := make([]*entity.ExamineeAnswerQuestionAnswer, 0, len(req.AnswerData))
-		for _, questionAnswerData := range req.AnswerData {
-			id, _ := isnowflake.SnowFlake.NextID(_const.ExamineeAnswerQuestionAnswerPrefix)
-			sign, _ := json.Marshal(questionAnswerData.OptionsSerialNumberData)
-			answers = append(answers, &entity.ExamineeAnswerQuestionAnswer{
-				ID:               id,
-				ExamineeAnswerID: examineeAnswer.ID,
-				QuestionID:       questionAnswerData.QuestionId,
-				Score:            0,
-				OptionSign:       string(sign),
-				CreatedBy:        userId,
-				UpdatedBy:        userId,
-			})
-		}
-		err = uc.examineeQuestionAnswerUC.SaveAnswer(ctx, answers)
-		if err != nil {
-			l.Errorf("HeartbeatAndSave.examineeQuestionAnswerUC.SaveAnswer Failed, req:%v, err:%v", req, err.Error())
+	if len(invalid) > 0 {
+		lockedOnly := true
+		for _, reason := range invalid {
+			if reason != AnswerSectionLocked && reason != AnswerSectionNotOpen && reason != AnswerAdaptiveAnswered {
+				lockedOnly = false
+				break
+			}
+		}
+		if eventType == _const.ExamEventSubmit && !lockedOnly {
+			err = innErr.ErrInvalidAnswer.WithMetadata(invalid)
+			return
+		}
+		l.Warnf("submit.validateAnswers dropped invalid answers, associationId:%v, invalid:%v", associationId, invalid)
+	}
+	err = uc.saveAnswers(ctx, examineeAnswer.ID, answerData)
+	if err != nil {
+		l.Errorf("submit.saveAnswers Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	answered, _, unanswered, err := uc.answerProgress(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("submit.answerProgress Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	// 试卷要求全部作答时，主动交卷须没有未作答的题目；自动交卷不受限制
+	if eventType == _const.ExamEventSubmit && len(unanswered) > 0 {
+		salesPaper, e := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+		if e != nil {
+			l.Errorf("submit.salesPaperUc.GetSalesPaperDetail Failed, associationId:%v, err:%v", associationId, e.Error())
 			err = innErr.ErrInternalServer
 			return
 		}
+		if salesPaper != nil && salesPaper.AllRequired {
+			err = innErr.ErrUnansweredQuestions.WithMetadata(map[string]string{"question_ids": strings.Join(unanswered, ",")})
+			return
+		}
 	}
-	// 10. 更新状态
-	err = uc.associationUc.UpdateStageNumber(ctx, associationId, v1.StageNumber_Submit)
+	// 9. 记录提交时间、剩余时间和作答题目数量
+	err = uc.repo.SubmitResult(ctx, examineeAnswer.ID, activeTime, limit, answered)
 	if err != nil {
-		l.Errorf("HeartbeatAndSave.examineeQuestionAnswerUC.SaveAnswer Failed, req:%v, err:%v", req, err.Error())
+		l.Errorf("submit.repo.SubmitResult Failed, associationId:%v, err:%v", associationId, err.Error())
 		err = innErr.ErrInternalServer
 		return
 	}
+	// 10. 更新状态
+	if !examineeAnswer.IsPractice {
+		err = uc.associationUc.UpdateStageNumber(ctx, associationId, v1.StageNumber_Submit)
+		if err != nil {
+			l.Errorf("submit.associationUc.UpdateStageNumber Failed, associationId:%v, err:%v", associationId, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+	}
 	// 11. 添加提交成功key，防止重放
 	_ = uc.redisRepo.Set(ctx, submitKey, "", submitKeyExpire)
-	// 12. 记录心跳事件
+	_ = uc.redisRepo.Del(ctx, fmt.Sprintf(_const.RedisActiveSessionKey, associationId), fmt.Sprintf(_const.RedisLatestSessionKey, associationId))
+	// 12. 记录提交事件
 	go itask.TaskWithContext(ctx, func() {
-		if e := uc.examEvent.ExamEvent(ctx, examineeAnswer.ID, _const.ExamEventSubmit, make(map[string]interface{})); e != nil {
-			l.Errorf("HeartbeatAndSave.examEvent.ExamEvent Failed, req:%v, err:%v", req, e.Error())
+		if e := uc.examEvent.ExamEvent(ctx, examineeAnswer.ID, eventType, make(map[string]interface{})); e != nil {
+			l.Errorf("submit.examEvent.ExamEvent Failed, associationId:%v, err:%v", associationId, e.Error())
+		}
+	}, l)
+	// 13. 异步算分（脱离请求上下文，避免请求结束后被取消）。
+	// 总分、维度得分、作答诚信分析和人工评分队列都在算分时产生，练习不算分，因此不计入成绩和统计
+	if examineeAnswer.IsPractice {
+		return
+	}
+	scoreCtx := icontext.Detach(ctx)
+	go itask.TaskWithContext(scoreCtx, func() {
+		if e := uc.scoreUc.CalculatePoints(scoreCtx, associationId); e != nil {
+			l.Errorf("submit.scoreUc.CalculatePoints Failed, associationId:%v, err:%v", associationId, e.Error())
 		}
 	}, l)
 	return
 }
 
+// saveAnswers 保存作答记录
+func (uc *ExamineeAnswerUseCase) saveAnswers(ctx context.Context, examineeAnswerId string, answerData []*v1.QuestionAnswerData) error {
+	if len(answerData) == 0 {
+		return nil
+	}
+	userId, _ := icontext.UserIdFrom(ctx)
+	sessionId, _ := icontext.SessionIdFrom(ctx)
+	receivedAt := time.Now()
+	answers := make([]*entity.ExamineeAnswerQuestionAnswer, 0, len(answerData))
+	revisions := make([]*entity.ExamineeAnswerQuestionRevision, 0, len(answerData))
+	for _, questionAnswerData := range answerData {
+		id, _ := isnowflake.SnowFlake.NextID(_const.ExamineeAnswerQuestionAnswerPrefix)
+		revisionId, _ := isnowflake.SnowFlake.NextID(_const.ExamineeAnswerQuestionRevisionPrefix)
+		sign, _ := json.Marshal(questionAnswerData.OptionsSerialNumberData)
+		// 用时和修改次数为可选项，未上报或上报负数时按0记录
+		timeSpentMs := max(questionAnswerData.TimeSpentMs, 0)
+		changeCount := max(questionAnswerData.ChangeCount, 0)
+		answers = append(answers, &entity.ExamineeAnswerQuestionAnswer{
+			ID:               id,
+			ExamineeAnswerID: examineeAnswerId,
+			QuestionID:       questionAnswerData.QuestionId,
+			Score:            0,
+			OptionSign:       string(sign),
+			TimeSpentMs:      timeSpentMs,
+			ChangeCount:      changeCount,
+			TextAnswer:       questionAnswerData.TextAnswer,
+			CreatedBy:        userId,
+			UpdatedBy:        userId,
+		})
+		revisions = append(revisions, &entity.ExamineeAnswerQuestionRevision{
+			ID:               revisionId,
+			ExamineeAnswerID: examineeAnswerId,
+			QuestionID:       questionAnswerData.QuestionId,
+			OptionSign:       string(sign),
+			TimeSpentMs:      timeSpentMs,
+			ChangeCount:      changeCount,
+			TextAnswer:       questionAnswerData.TextAnswer,
+			SessionID:        sessionId,
+			ReceivedAt:       receivedAt,
+			CreatedBy:        userId,
+			UpdatedBy:        userId,
+		})
+	}
+	return uc.examineeQuestionAnswerUC.SaveAnswer(ctx, answers, revisions)
+}
+
+// attemptQuestionIds 返回本次作答抽取的题目id集合，为 nil 表示作答试卷全部题目
+func attemptQuestionIds(examineeAnswer *entity.ExamineeAnswer) map[string]struct{} {
+	if examineeAnswer.QuestionIds == "" {
+		return nil
+	}
+	questionIds := make([]string, 0)
+	json.Unmarshal([]byte(examineeAnswer.QuestionIds), &questionIds)
+	mQuestionId := make(map[string]struct{}, len(questionIds))
+	for _, questionId := range questionIds {
+		mQuestionId[questionId] = struct{}{}
+	}
+	return mQuestionId
+}
+
+// attemptQuestions 返回本次作答的题目（原顺序）：试卷按规则抽题时只保留开始考试时抽取的题目
+func (uc *ExamineeAnswerUseCase) attemptQuestions(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) (questions []*v1.QuestionData, err error) {
+	resp, err := uc.questionUc.ExamQuestion(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	mQuestionId := attemptQuestionIds(examineeAnswer)
+	if mQuestionId == nil {
+		return resp.QuestionData, nil
+	}
+	questions = make([]*v1.QuestionData, 0, len(mQuestionId))
+	for _, question := range resp.QuestionData {
+		if _, ok := mQuestionId[question.QuestionId]; ok {
+			questions = append(questions, question)
+		}
+	}
+	return
+}
+
+// answerProgress 按已保存的答案统计作答进度：已作答题目数、本次作答题目总数和未作答的题目id（按题目顺序）。
+// 只统计本次作答的题目，选项和文字答案都为空的视为未作答
+func (uc *ExamineeAnswerUseCase) answerProgress(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) (answered, total int32, unanswered []string, err error) {
+	questions, err := uc.attemptQuestions(ctx, examineeAnswer)
+	if err != nil {
+		return
+	}
+	answers, err := uc.examineeQuestionAnswerUC.GetByExamineeAnswerId(ctx, examineeAnswer.ID)
+	if err != nil {
+		return
+	}
+	answeredIds := make(map[string]struct{}, len(answers))
+	for _, answer := range answers {
+		options := make([]string, 0)
+		if answer.OptionSign != "" {
+			json.Unmarshal([]byte(answer.OptionSign), &options)
+		}
+		if len(options) > 0 || strings.TrimSpace(answer.TextAnswer) != "" {
+			answeredIds[answer.QuestionID] = struct{}{}
+		}
+	}
+	unanswered = make([]string, 0)
+	for _, question := range questions {
+		if _, ok := answeredIds[question.QuestionId]; ok {
+			answered++
+			continue
+		}
+		unanswered = append(unanswered, question.QuestionId)
+	}
+	total = int32(len(questions))
+	return
+}
+
+// validateAnswers 按本次作答的题目（走 QuestionUseCase.ExamQuestion 的缓存）校验答案：题目须属于本次作答、选项须存在且不重复，
+// 单选和判断题必须且只能选一个选项。试卷打乱选项顺序时先将考生看到的字母换回原顺序再校验。
+// 返回换回原顺序后的合法答案，以及不合法答案的题目id -> 错误原因
+func (uc *ExamineeAnswerUseCase) validateAnswers(ctx context.Context, examineeAnswer *entity.ExamineeAnswer, answerData []*v1.QuestionAnswerData) (valid []*v1.QuestionAnswerData, invalid map[string]string, err error) {
+	if len(answerData) == 0 {
+		return answerData, nil, nil
+	}
+	salesPaper, err := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return nil, nil, err
+	}
+	questions, err := uc.attemptQuestions(ctx, examineeAnswer)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := uc.loadSectionPlan(ctx, examineeAnswer, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	adaptive, err := parseAdaptiveState(examineeAnswer.AdaptiveState)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 已计入能力估计的题目只允许原样重复提交，需要对照已保存的答案
+	mSaved := make(map[string]*entity.ExamineeAnswerQuestionAnswer)
+	if len(adaptive.Dimensions) > 0 {
+		saved, e := uc.examineeQuestionAnswerUC.GetByExamineeAnswerId(ctx, examineeAnswer.ID)
+		if e != nil {
+			return nil, nil, e
+		}
+		for _, answer := range saved {
+			mSaved[answer.QuestionID] = answer
+		}
+	}
+	mQuestion := make(map[string]*v1.QuestionData, len(questions))
+	for _, question := range questions {
+		mQuestion[question.QuestionId] = question
+	}
+	valid = make([]*v1.QuestionAnswerData, 0, len(answerData))
+	invalid = make(map[string]string)
+	for _, answer := range answerData {
+		question := mQuestion[answer.QuestionId]
+		cur := &v1.QuestionAnswerData{
+			QuestionId:              answer.QuestionId,
+			OptionsSerialNumberData: answer.OptionsSerialNumberData,
+			TimeSpentMs:             answer.TimeSpentMs,
+			ChangeCount:             answer.ChangeCount,
+			TextAnswer:              answer.TextAnswer,
+		}
+		if question != nil {
+			cur.OptionsSerialNumberData = encodeAnswer(question.QuestionTypeId, answer)
+			if salesPaper.ShuffleOptions {
+				cur.OptionsSerialNumberData = toCanonicalLetters(question, examineeAnswer.ID, cur.OptionsSerialNumberData)
+			}
+		}
+		if reason := checkAnswer(question, cur); reason != "" {
+			invalid[answer.QuestionId] = reason
+			continue
+		}
+		// 自适应试卷已计入能力估计的答案不能再修改，客户端原样重复提交的直接忽略
+		if adaptive.answered(answer.QuestionId) {
+			if !sameAnswer(mSaved[answer.QuestionId], cur) {
+				invalid[answer.QuestionId] = AnswerAdaptiveAnswered
+			}
+			continue
+		}
+		// 分部试卷只能保存当前分部的答案
+		if plan != nil {
+			if reason := plan.checkQuestion(answer.QuestionId); reason != "" {
+				invalid[answer.QuestionId] = reason
+				continue
+			}
+		}
+		valid = append(valid, cur)
+	}
+	return valid, invalid, nil
+}
+
+// sameAnswer 提交的答案（已换回原顺序）是否与已保存的答案相同
+func sameAnswer(saved *entity.ExamineeAnswerQuestionAnswer, answer *v1.QuestionAnswerData) bool {
+	if saved == nil {
+		return false
+	}
+	options := make([]string, 0)
+	if saved.OptionSign != "" {
+		json.Unmarshal([]byte(saved.OptionSign), &options)
+	}
+	return slices.Equal(options, answer.OptionsSerialNumberData) && saved.TextAnswer == answer.TextAnswer
+}
+
+// 答案校验失败原因
+const (
+	AnswerQuestionNotInPaper = "QUESTION_NOT_IN_PAPER" // 题目不属于该试卷
+	AnswerOptionNotExist     = "OPTION_NOT_EXIST"      // 选项不存在
+	AnswerOptionDuplicate    = "OPTION_DUPLICATE"      // 选项重复
+	AnswerSingleOption       = "SINGLE_OPTION"         // 单选、判断、量表题必须且只能选一个选项
+	AnswerRankingIncomplete  = "RANKING_INCOMPLETE"    // 排序题必须对全部选项排序
+	AnswerForcedChoice       = "FORCED_CHOICE"         // 迫选题必须分别选出最符合和最不符合的选项
+	AnswerNumericInvalid     = "NUMERIC_INVALID"       // 数值题答案不是合法数字
+)
+
+// isTextQuestion 是否为作答文字的题型（填空、数值、问答），答案保存在 TextAnswer
+func isTextQuestion(questionType v1.QuestionType) bool {
+	switch questionType {
+	case v1.QuestionType_FillIn, v1.QuestionType_Numeric, v1.QuestionType_Essay:
+		return true
+	}
+	return false
+}
+
+// encodeAnswer 将不同题型的作答内容统一编码为字母列表，保存到 OptionSign：
+// 排序题为按名次排列的全部选项，迫选题为 [最符合, 最不符合]，文字题型没有选项，其余题型为所选的选项
+func encodeAnswer(questionType v1.QuestionType, answer *v1.QuestionAnswerData) []string {
+	if isTextQuestion(questionType) {
+		return []string{}
+	}
+	switch questionType {
+	case v1.QuestionType_Ranking:
+		return answer.RankingSerialNumberData
+	case v1.QuestionType_ForcedChoice:
+		if answer.MostSerialNumber == "" && answer.LeastSerialNumber == "" {
+			return []string{}
+		}
+		return []string{answer.MostSerialNumber, answer.LeastSerialNumber}
+	default:
+		return answer.OptionsSerialNumberData
+	}
+}
+
+// decodeAnswer 将 OptionSign 保存的字母列表按题型还原到答案的对应字段，encodeAnswer 的逆过程
+func decodeAnswer(questionType v1.QuestionType, letters []string, answer *v1.QuestionAnswerData) {
+	switch questionType {
+	case v1.QuestionType_Ranking:
+		answer.RankingSerialNumberData = letters
+	case v1.QuestionType_ForcedChoice:
+		if len(letters) == 2 {
+			answer.MostSerialNumber, answer.LeastSerialNumber = letters[0], letters[1]
+		}
+	default:
+		answer.OptionsSerialNumberData = letters
+	}
+}
+
+// checkAnswer 校验单个答案，合法时返回空字符串
+func checkAnswer(question *v1.QuestionData, answer *v1.QuestionAnswerData) string {
+	if question == nil {
+		return AnswerQuestionNotInPaper
+	}
+	serialNumbers := make(map[string]struct{}, len(question.QuestionOptionsData))
+	for _, option := range question.QuestionOptionsData {
+		serialNumbers[option.SerialNumber] = struct{}{}
+	}
+	selected := make(map[string]struct{}, len(answer.OptionsSerialNumberData))
+	for _, serialNumber := range answer.OptionsSerialNumberData {
+		if _, ok := serialNumbers[serialNumber]; !ok {
+			return AnswerOptionNotExist
+		}
+		if _, ok := selected[serialNumber]; ok {
+			return AnswerOptionDuplicate
+		}
+		selected[serialNumber] = struct{}{}
+	}
+	switch question.QuestionTypeId {
+	case v1.QuestionType_RadioChoice, v1.QuestionType_Judge, v1.QuestionType_Likert:
+		if len(selected) != 1 {
+			return AnswerSingleOption
+		}
+	case v1.QuestionType_Ranking:
+		if len(selected) > 0 && len(selected) != len(serialNumbers) {
+			return AnswerRankingIncomplete
+		}
+	case v1.QuestionType_ForcedChoice:
+		if len(selected) > 0 && len(selected) != 2 {
+			return AnswerForcedChoice
+		}
+	case v1.QuestionType_Numeric:
+		if text := strings.TrimSpace(answer.TextAnswer); text != "" {
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return AnswerNumericInvalid
+			}
+		}
+	}
+	return ""
+}
+
+// chargedSeconds 距上次心跳计入作答用时的秒数：考生断线期间不计时，每次最多计 heartbeatChargeCap 秒
+func chargedSeconds(lastActionTime, now time.Time) float64 {
+	if lastActionTime.IsZero() {
+		return 0
+	}
+	return math.Max(0, math.Min(now.Sub(lastActionTime).Seconds(), heartbeatChargeCap))
+}
+
+// remainingSeconds 扣除本次耗时后的剩余作答时间，心跳、交卷和后台扫描都按此计算。
+// 断线时间超过剩余时长（已过 DueAt）视为时间用完，后台扫描按 DueAt 自动交卷，考生不再发送请求也成立
+func remainingSeconds(examineeAnswer *entity.ExamineeAnswer, now time.Time) int32 {
+	if !examineeAnswer.DueAt.IsZero() && !now.Before(examineeAnswer.DueAt) {
+		return 0
+	}
+	return examineeAnswer.RemainingTimelimit - int32(chargedSeconds(examineeAnswer.LastActionTime, now))
+}
+
+// ExamQuestion 获取本次作答的试卷题目，按试卷设置以作答id为种子打乱题目和选项顺序，同一作答每次顺序相同
+func (uc *ExamineeAnswerUseCase) ExamQuestion(ctx context.Context, req *v1.ExamQuestionRequest) (resp *v1.ExamQuestionResponse, err error) {
+	resp = &v1.ExamQuestionResponse{QuestionData: make([]*v1.QuestionData, 0)}
+	var (
+		l                = uc.log.WithContext(ctx)
+		associationId, _ = icontext.AssociationIdFrom(ctx)
+	)
+	examineeAnswer, err := uc.repo.GetByAssociationId(ctx, associationId)
+	if err != nil {
+		l.Errorf("ExamQuestion.repo.GetByAssociationId Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if examineeAnswer == nil {
+		err = errors.New("试卷不存在")
+		return
+	}
+	salesPaper, err := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	//获取试卷题目
+	questions, err := uc.attemptQuestions(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("ExamQuestion.attemptQuestions Failed, req:%v, salesPaperId:%v, err:%v", req, examineeAnswer.SalesPaperID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	// 分部试卷一次只下发一个分部的题目
+	now := time.Now()
+	plan, err := uc.loadSectionPlan(ctx, examineeAnswer, now)
+	if err != nil {
+		l.Errorf("ExamQuestion.loadSectionPlan Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if plan != nil {
+		section, e := uc.enterSection(ctx, examineeAnswer, plan, req.SectionId, now)
+		if e != nil {
+			err = e
+			return
+		}
+		questions = slices.DeleteFunc(slices.Clone(questions), func(question *v1.QuestionData) bool {
+			return plan.mQuestionSection[question.QuestionId] != section.ID
+		})
+		resp.Section = plan.toSectionData(section, now)
+		for _, v := range plan.sections {
+			resp.Sections = append(resp.Sections, plan.toSectionData(v, now))
+		}
+	}
+	resp.QuestionData = shuffleQuestions(questions, examineeAnswer.ID, salesPaper.ShuffleQuestions, salesPaper.ShuffleOptions)
+	if err = uc.signAttachments(ctx, resp.QuestionData); err != nil {
+		l.Errorf("ExamQuestion.signAttachments Failed, salesPaperId:%v, err:%v", examineeAnswer.SalesPaperID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// signAttachments 将题干和选项附件的存储key换成带签名的访问地址。附件列表替换为新的对象，不修改缓存的题目数据
+func (uc *ExamineeAnswerUseCase) signAttachments(ctx context.Context, questions []*v1.QuestionData) error {
+	sign := func(attachments []*v1.Attachment) ([]*v1.Attachment, error) {
+		if len(attachments) == 0 {
+			return attachments, nil
+		}
+		res := make([]*v1.Attachment, 0, len(attachments))
+		for _, attachment := range attachments {
+			signedURL, expiresAt, err := uc.storage.SignURL(ctx, attachment.Url)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, &v1.Attachment{MediaType: attachment.MediaType, Url: signedURL, ExpiresAt: expiresAt.UnixMilli()})
+		}
+		return res, nil
+	}
+	var err error
+	for _, question := range questions {
+		if question.Attachments, err = sign(question.Attachments); err != nil {
+			return err
+		}
+		options := make([]*v1.QuestionOptionData, 0, len(question.QuestionOptionsData))
+		for _, option := range question.QuestionOptionsData {
+			cur := &v1.QuestionOptionData{
+				QuestionOptionId: option.QuestionOptionId,
+				Description:      option.Description,
+				SerialNumber:     option.SerialNumber,
+			}
+			if cur.Attachments, err = sign(option.Attachments); err != nil {
+				return err
+			}
+			options = append(options, cur)
+		}
+		question.QuestionOptionsData = options
+	}
+	return nil
+}
+
 func (uc *ExamineeAnswerUseCase) ExamQuestionRecord(ctx context.Context, req *v1.ExamQuestionRecordRequest) (resp *v1.ExamQuestionRecordResponse, err error) {
 
 	resp = &v1.ExamQuestionRecordResponse{AnswerData: make([]*v1.QuestionAnswerData, 0)}
@@ -405,16 +1012,444 @@ func (uc *ExamineeAnswerUseCase) ExamQuestionRecord(ctx context.Context, req *v1
 	if len(examineeAnswers) == 0 {
 		return
 	}
+	salesPaper, err := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	questions, err := uc.attemptQuestions(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("ExamQuestionRecord.attemptQuestions Failed, salesPaperId:%v, err:%v", examineeAnswer.SalesPaperID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	mQuestion := make(map[string]*v1.QuestionData, len(questions))
+	for _, question := range questions {
+		mQuestion[question.QuestionId] = question
+	}
 	resp.AnswerData = make([]*v1.QuestionAnswerData, 0, len(examineeAnswers))
 	for _, answer := range examineeAnswers {
 		options := make([]string, 0)
 		if answer.OptionSign != "" {
 			json.Unmarshal([]byte(answer.OptionSign), &options)
 		}
-		resp.AnswerData = append(resp.AnswerData, &v1.QuestionAnswerData{
+		cur := &v1.QuestionAnswerData{
 			QuestionId:              answer.QuestionID,
 			OptionsSerialNumberData: options,
+			TextAnswer:              answer.TextAnswer,
+		}
+		// 试卷打乱选项顺序时，答案换成考生看到的字母；再按题型还原到对应字段
+		if question, ok := mQuestion[answer.QuestionID]; ok {
+			if salesPaper.ShuffleOptions {
+				options = toDisplayLetters(question, examineeAnswer.ID, options)
+			}
+			cur.OptionsSerialNumberData = nil
+			decodeAnswer(question.QuestionTypeId, options, cur)
+		}
+		resp.AnswerData = append(resp.AnswerData, cur)
+	}
+	return
+}
+
+// canViewTimeline 核查人员可以回放任意考试，招聘人员只能回放自己安排的考试，考生只能回放自己的考试（用于申诉）
+func canViewTimeline(association *entity.ExamineeSalesPaperAssociation, userId, role string) bool {
+	switch role {
+	case _const.RoleInvestigator:
+		return true
+	case _const.RoleRecruiter:
+		return association.CreatedBy == userId
+	}
+	return association.ExamineeID == userId
+}
+
+// ExamAnswerTimeline 按服务端接收顺序回放一次作答的答案修改记录，用于申诉和作弊核查。
+// 每条记录同时返回原顺序字母和考生看到的字母，打乱选项的试卷可以对照考生当时的界面
+func (uc *ExamineeAnswerUseCase) ExamAnswerTimeline(ctx context.Context, req *v1.ExamAnswerTimelineRequest) (resp *v1.ExamAnswerTimelineResponse, err error) {
+	resp = &v1.ExamAnswerTimelineResponse{Revisions: make([]*v1.AnswerRevision, 0)}
+	var (
+		l         = uc.log.WithContext(ctx)
+		userId, _ = icontext.UserIdFrom(ctx)
+		role, _   = icontext.UserRuleFrom(ctx)
+	)
+	association, err := uc.associationUc.GetById(ctx, req.AssociationId)
+	if err != nil {
+		l.Errorf("ExamAnswerTimeline.associationUc.GetById Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if association == nil || !canViewTimeline(association, userId, role) {
+		err = errors.New("考试不存在")
+		return
+	}
+	examineeAnswer, err := uc.repo.GetByAssociationId(ctx, req.AssociationId)
+	if err != nil {
+		l.Errorf("ExamAnswerTimeline.repo.GetByAssociationId Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if examineeAnswer == nil {
+		return
+	}
+	revisions, err := uc.examineeQuestionAnswerUC.GetRevisionsByExamineeAnswerId(ctx, examineeAnswer.ID)
+	if err != nil {
+		l.Errorf("ExamAnswerTimeline.examineeQuestionAnswerUC.GetRevisionsByExamineeAnswerId Failed, examineeAnswer.ID:%v, err:%v", examineeAnswer.ID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if len(revisions) == 0 {
+		return
+	}
+	salesPaper, err := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		return
+	}
+	// 打乱选项的试卷需要按本次作答的排列还原考生看到的字母
+	mQuestion := make(map[string]*v1.QuestionData)
+	if salesPaper.ShuffleOptions {
+		var questions []*v1.QuestionData
+		questions, err = uc.attemptQuestions(ctx, examineeAnswer)
+		if err != nil {
+			l.Errorf("ExamAnswerTimeline.attemptQuestions Failed, salesPaperId:%v, err:%v", examineeAnswer.SalesPaperID, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		for _, question := range questions {
+			mQuestion[question.QuestionId] = question
+		}
+	}
+	resp.Revisions = make([]*v1.AnswerRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		options := make([]string, 0)
+		if revision.OptionSign != "" {
+			json.Unmarshal([]byte(revision.OptionSign), &options)
+		}
+		displayOptions := options
+		if question, ok := mQuestion[revision.QuestionID]; ok {
+			displayOptions = toDisplayLetters(question, examineeAnswer.ID, options)
+		}
+		resp.Revisions = append(resp.Revisions, &v1.AnswerRevision{
+			QuestionId:                     revision.QuestionID,
+			Seq:                            revision.Seq,
+			OptionsSerialNumberData:        options,
+			DisplayOptionsSerialNumberData: displayOptions,
+			TimeSpentMs:                    revision.TimeSpentMs,
+			ChangeCount:                    revision.ChangeCount,
+			TextAnswer:                     revision.TextAnswer,
+			SessionId:                      revision.SessionID,
+			ReceivedAt:                     revision.ReceivedAt.UnixMilli(),
 		})
 	}
 	return
 }
+
+// Sweep 扫描进行中但剩余时间已用完或已过截止时间的考试：时间用完的自动交卷，过了截止时间的置为已过期；
+// 再对停留在已提交、已评完的待人工评分或处理失败阶段的考试重新算分（有次数上限）。
+// 多实例部署时通过 redis 租约保证同时只有一个实例执行，扫描结束后释放租约；租约时长只用于实例异常退出时兜底。
+// 处理失败的考试留待下一周期重试，本次按分页跳过，不阻塞后面的考试
+func (uc *ExamineeAnswerUseCase) Sweep(ctx context.Context, lease time.Duration) (err error) {
+	l := uc.log.WithContext(ctx)
+	leaseValue, _ := isnowflake.SnowFlake.NextID("lease")
+	ok, err := uc.redisRepo.SetNX(ctx, _const.RedisSweeperLeaseKey, leaseValue, lease)
+	if err != nil {
+		l.Errorf("Sweep.redisRepo.SetNX Failed, err:%v", err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if !ok {
+		// 其他实例持有租约
+		return
+	}
+	defer func() {
+		result, e := uc.redisRepo.Eval(icontext.Detach(ctx), _const.UnlockScript, []string{_const.RedisSweeperLeaseKey}, leaseValue)
+		if e != nil {
+			l.Errorf("Sweep.redisRepo.Eval Failed, err:%v", e.Error())
+		} else if n, ok := result.(int64); !ok || n == 0 {
+			l.Warnf("Sweep lease already expired or taken over, key:%v", _const.RedisSweeperLeaseKey)
+		}
+	}()
+	now := time.Now()
+	var after *entity.ExamineeAnswer
+	for ctx.Err() == nil {
+		list, e := uc.repo.GetOverdueList(ctx, now, after, sweepBatchSize)
+		if e != nil {
+			l.Errorf("Sweep.repo.GetOverdueList Failed, err:%v", e.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+		for _, examineeAnswer := range list {
+			uc.sweepOne(ctx, examineeAnswer, now)
+		}
+		if len(list) < sweepBatchSize {
+			break
+		}
+		after = list[len(list)-1]
+	}
+	err = uc.rescore(ctx, now)
+	return
+}
+
+// rescore 对已提交后异步算分丢失、评分完成后异步算分丢失、或算分处理失败的考试重新算分。
+// 处理失败会刷新更新时间，因此每条考试最多每 rescoreDelay 重试一次，最多重试 maxRescoreTimes 次
+func (uc *ExamineeAnswerUseCase) rescore(ctx context.Context, now time.Time) (err error) {
+	afterId := ""
+	for ctx.Err() == nil {
+		list, e := uc.associationUc.GetRescoreList(ctx, now.Add(-rescoreDelay), maxRescoreTimes, afterId, sweepBatchSize)
+		if e != nil {
+			err = e
+			return
+		}
+		for _, association := range list {
+			uc.rescoreOne(ctx, association)
+		}
+		if len(list) < sweepBatchSize {
+			break
+		}
+		afterId = list[len(list)-1].ID
+	}
+	return
+}
+
+// rescoreOne 重新算分一场考试，待人工评分的考试只在全部评完后算分。失败只记录日志，下一周期重试
+func (uc *ExamineeAnswerUseCase) rescoreOne(ctx context.Context, association *entity.ExamineeSalesPaperAssociation) {
+	l := uc.log.WithContext(ctx)
+	if association.StageNumber == int32(v1.StageNumber_AwaitingGrading) {
+		examineeAnswer, e := uc.repo.GetByAssociationId(ctx, association.ID)
+		if e != nil {
+			l.Errorf("Sweep.repo.GetByAssociationId Failed, associationId:%v, err:%v", association.ID, e.Error())
+			return
+		}
+		if examineeAnswer != nil {
+			pending, e := uc.examineeQuestionAnswerUC.CountPendingGrading(ctx, examineeAnswer.ID)
+			if e != nil {
+				l.Errorf("Sweep.examineeQuestionAnswerUC.CountPendingGrading Failed, associationId:%v, err:%v", association.ID, e.Error())
+				return
+			}
+			if pending > 0 {
+				return
+			}
+		}
+	}
+	// 先累加次数，算分过程中实例退出也计入重试次数
+	if e := uc.associationUc.IncrRescoreTimes(ctx, association.ID); e != nil {
+		return
+	}
+	if e := uc.scoreUc.CalculatePoints(ctx, association.ID); e != nil {
+		l.Errorf("Sweep.scoreUc.CalculatePoints Failed, associationId:%v, times:%v, err:%v", association.ID, association.RescoreTimes+1, e.Error())
+		if association.RescoreTimes+1 >= maxRescoreTimes {
+			l.Warnf("Sweep rescore gave up, associationId:%v, times:%v", association.ID, association.RescoreTimes+1)
+		}
+	}
+}
+
+// sweepOne 处理一条超时或过期的作答，失败只记录日志，下一周期重新扫描时重试
+func (uc *ExamineeAnswerUseCase) sweepOne(ctx context.Context, examineeAnswer *entity.ExamineeAnswer, now time.Time) {
+	l := uc.log.WithContext(ctx)
+	associationId := examineeAnswer.ExamineeSalesPaperAssociationID
+	if remainingSeconds(examineeAnswer, now) <= 0 || examineeAnswer.IsPractice {
+		// 时间用完（包括考生离开后已过 DueAt），按交卷流程自动提交（答案已通过心跳保存）；练习过了截止时间也直接交卷，不改变考试状态
+		if e := uc.submit(ctx, associationId, nil, _const.ExamEventTimeUp); e != nil {
+			l.Errorf("Sweep.submit Failed, associationId:%v, err:%v", associationId, e.Error())
+		}
+		return
+	}
+	if e := uc.expire(ctx, examineeAnswer); e != nil {
+		l.Errorf("Sweep.expire Failed, associationId:%v, err:%v", associationId, e.Error())
+	}
+}
+
+// latestSessionValue 最近签发的会话在 redis 中的值：会话id|客户端指纹
+func latestSessionValue(sessionId, clientFP string) string {
+	return sessionId + "|" + clientFP
+}
+
+func parseLatestSession(value string) (sessionId, clientFP string) {
+	sessionId, clientFP, _ = strings.Cut(value, "|")
+	return
+}
+
+// attemptInProgress 作答是否进行中：练习不改变考试状态，以未交卷为准
+func attemptInProgress(association *entity.ExamineeSalesPaperAssociation, examineeAnswer *entity.ExamineeAnswer) bool {
+	if examineeAnswer.IsPractice {
+		return examineeAnswer.SubmitTime == nil
+	}
+	return association.StageNumber == int32(v1.StageNumber_InProgress)
+}
+
+// discardPractice 丢弃上一次练习，清除交卷标记和有效会话，以便重新开始
+func (uc *ExamineeAnswerUseCase) discardPractice(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) error {
+	associationId := examineeAnswer.ExamineeSalesPaperAssociationID
+	if err := uc.repo.Delete(ctx, examineeAnswer.ID); err != nil {
+		return err
+	}
+	return uc.redisRepo.Del(ctx, fmt.Sprintf(_const.RedisSubmitKey, associationId), fmt.Sprintf(_const.RedisActiveSessionKey, associationId), fmt.Sprintf(_const.RedisLatestSessionKey, associationId))
+}
+
+// expire 将过了截止时间仍未交卷的考试置为已过期并记录事件
+func (uc *ExamineeAnswerUseCase) expire(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) (err error) {
+	// 练习不改变考试状态
+	if !examineeAnswer.IsPractice {
+		err = uc.associationUc.UpdateStageNumber(ctx, examineeAnswer.ExamineeSalesPaperAssociationID, v1.StageNumber_Expire)
+		if err != nil {
+			return
+		}
+	}
+	return uc.examEvent.ExamEvent(ctx, examineeAnswer.ID, _const.ExamEventExpire, map[string]interface{}{
+		"deadline": examineeAnswer.Deadline.Format(time.DateTime),
+	})
+}
+
+// RecordFingerprintMismatch 记录考试令牌客户端指纹变化事件，包含签发时和本次请求的指纹组成部分，同一会话的同一指纹变化只记录一次
+func (uc *ExamineeAnswerUseCase) RecordFingerprintMismatch(ctx context.Context, associationId string, mismatch *ijwt.FingerprintMismatch) {
+	l := uc.log.WithContext(ctx)
+	eventCtx := icontext.Detach(ctx)
+	sessionId, _ := icontext.SessionIdFrom(ctx)
+	go itask.TaskWithContext(eventCtx, func() {
+		// 宽松和只记录策略下每个请求都会带着同样的变化，同一会话的同一指纹只记录一次
+		eventKey := fmt.Sprintf(_const.RedisFingerprintEventKey, sessionId, mismatch.CurrentFP)
+		first, err := uc.redisRepo.SetNX(eventCtx, eventKey, "", fingerprintEventExpire)
+		if err != nil {
+			l.Errorf("RecordFingerprintMismatch.redisRepo.SetNX Failed, associationId:%v, err:%v", associationId, err.Error())
+			return
+		}
+		if !first {
+			return
+		}
+		examineeAnswer, err := uc.repo.GetByAssociationId(eventCtx, associationId)
+		if err != nil || examineeAnswer == nil {
+			l.Errorf("RecordFingerprintMismatch.repo.GetByAssociationId Failed, associationId:%v, err:%v", associationId, err)
+			return
+		}
+		meta := map[string]interface{}{
+			"policy":   mismatch.Policy,
+			"allowed":  mismatch.Allowed,
+			"original": mismatch.Original,
+			"current":  mismatch.Current,
+		}
+		if e := uc.examEvent.ExamEvent(eventCtx, examineeAnswer.ID, _const.ExamEventFingerprint, meta); e != nil {
+			l.Errorf("RecordFingerprintMismatch.examEvent.ExamEvent Failed, associationId:%v, err:%v", associationId, e.Error())
+		}
+	}, l)
+}
+
+// CheckActiveSession 校验会话是否为考试当前有效的会话，并刷新空闲过期时间。
+// 没有在线会话（已空闲过期）时，只有最近一次进入考试签发的会话可以重新上线
+func (uc *ExamineeAnswerUseCase) CheckActiveSession(ctx context.Context, associationId, sessionId string) (bool, error) {
+	sessionKey := fmt.Sprintf(_const.RedisActiveSessionKey, associationId)
+	activeSessionId, err := uc.redisRepo.Get(ctx, sessionKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+	if activeSessionId == "" {
+		// 会话空闲过期后只有最近签发的会话可以重新上线，被顶替的会话不能再接管
+		latest, err := uc.redisRepo.Get(ctx, fmt.Sprintf(_const.RedisLatestSessionKey, associationId))
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return false, err
+		}
+		if latestSessionId, _ := parseLatestSession(latest); latestSessionId != sessionId {
+			return false, nil
+		}
+		ok, err := uc.redisRepo.SetNX(ctx, sessionKey, sessionId, activeSessionIdle)
+		if err != nil || ok {
+			return ok, err
+		}
+		// 并发被其他会话接管
+		activeSessionId, err = uc.redisRepo.Get(ctx, sessionKey)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return false, err
+		}
+	}
+	if activeSessionId != sessionId {
+		return false, nil
+	}
+	return true, uc.redisRepo.Expire(ctx, sessionKey, activeSessionIdle)
+}
+
+// checkClientEvents 校验浏览器上报的事件：事件类型须在白名单内，附加信息不超过项数和字节数限制
+func checkClientEvents(events []*v1.ClientExamEvent) error {
+	if len(events) > reportEventBatchSize {
+		return fmt.Errorf("单次最多上报%d条事件", reportEventBatchSize)
+	}
+	for _, event := range events {
+		if _, ok := _const.ClientExamEventTypes[_const.ExamEventType(event.EventType)]; !ok {
+			return fmt.Errorf("不支持的事件类型：%s", event.EventType)
+		}
+		if len(event.Meta) > reportEventMetaKeys {
+			return fmt.Errorf("事件附加信息最多%d项", reportEventMetaKeys)
+		}
+		size := 0
+		for k, v := range event.Meta {
+			size += len(k) + len(v)
+		}
+		if size > reportEventMetaBytes {
+			return fmt.Errorf("事件附加信息最多%d字节", reportEventMetaBytes)
+		}
+	}
+	return nil
+}
+
+// incrWithExpire 原子地累加计数并在计数没有过期时间时设置过期时间，避免设置过期时间失败后计数永不过期
+func incrWithExpire(ctx context.Context, redisRepo RedisRepository, key string, expiration time.Duration) (int64, error) {
+	result, err := redisRepo.Eval(ctx, _const.IncrWithExpireScript, []string{key}, int64(expiration.Seconds()))
+	if err != nil {
+		return 0, err
+	}
+	count, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected incr result: %v", result)
+	}
+	return count, nil
+}
+
+// ReportExamEvents 接收浏览器批量上报的监考事件：校验事件类型白名单和附加信息大小，按会话限流，只接收进行中的作答
+func (uc *ExamineeAnswerUseCase) ReportExamEvents(ctx context.Context, req *v1.ReportExamEventsRequest) (resp *v1.ReportExamEventsResponse, err error) {
+	resp = &v1.ReportExamEventsResponse{}
+	var (
+		l                = uc.log.WithContext(ctx)
+		associationId, _ = icontext.AssociationIdFrom(ctx)
+		sessionId, _     = icontext.SessionIdFrom(ctx)
+	)
+	if len(req.Events) == 0 {
+		return
+	}
+	if err = checkClientEvents(req.Events); err != nil {
+		return
+	}
+	// 按会话限流
+	rateKey := fmt.Sprintf(_const.RedisExamEventRateKey, sessionId)
+	count, err := incrWithExpire(ctx, uc.redisRepo, rateKey, time.Minute)
+	if err != nil {
+		l.Errorf("ReportExamEvents.incrWithExpire Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if count > reportEventRateLimit {
+		err = innErr.ErrExamEventRateLimit
+		return
+	}
+	examineeAnswer, err := uc.repo.GetByAssociationId(ctx, associationId)
+	if err != nil {
+		l.Errorf("ReportExamEvents.repo.GetByAssociationId Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	association, err := uc.associationUc.GetById(ctx, associationId)
+	if err != nil {
+		l.Errorf("ReportExamEvents.associationUc.GetById Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if association == nil || examineeAnswer == nil {
+		err = errors.New("考试记录不存在")
+		return
+	}
+	// 交卷或过期后不再接收事件，避免污染诚信分析
+	if !attemptInProgress(association, examineeAnswer) {
+		err = errors.New("考试状态异常")
+		return
+	}
+	err = uc.examEvent.BatchClientEvent(ctx, examineeAnswer.ID, req.Events)
+	if err != nil {
+		return
+	}
+	resp.Accepted = int32(len(req.Events))
+	return
+}
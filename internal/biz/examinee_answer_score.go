@@ -0,0 +1,354 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/conf"
+	_const "exam_api/internal/const"
+	"exam_api/internal/data/entity"
+	innErr "exam_api/internal/pkg/ierrors"
+	"exam_api/internal/pkg/iformula"
+	"exam_api/internal/pkg/isnowflake"
+	"exam_api/internal/pkg/iutils"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"slices"
+	"sync"
+)
+
+type ExamineeAnswerDimensionScoreRepo interface {
+	GetByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamineeAnswerDimensionScore, err error)
+	SaveScores(ctx context.Context, examineeAnswerId string, scores []*entity.ExamineeAnswerDimensionScore) error
+}
+
+type ExamineeAnswerScoreUseCase struct {
+	repo                     ExamineeAnswerDimensionScoreRepo
+	examineeAnswerRepo       ExamineeAnswerRepo
+	associationUc            *ExamineeSalesPaperAssociationUseCase
+	salesPaperUc             *SalesPaperUseCase
+	questionUc               *QuestionUseCase
+	examineeQuestionAnswerUC *ExamineeQuestionAnswerUseCase
+	examEvent                *ExamEventUseCase
+	defaultFormula           *conf.Data_StandardScoreFormulaConfig
+	formulas                 sync.Map // 试卷id -> *iformula.Program，按试卷缓存编译后的公式
+	log                      *log.Helper
+}
+
+func NewExamineeAnswerScoreUseCase(repo ExamineeAnswerDimensionScoreRepo,
+	examineeAnswerRepo ExamineeAnswerRepo,
+	associationUc *ExamineeSalesPaperAssociationUseCase,
+	salesPaperUc *SalesPaperUseCase,
+	questionUc *QuestionUseCase,
+	examineeQuestionAnswerUC *ExamineeQuestionAnswerUseCase,
+	examEvent *ExamEventUseCase,
+	c *conf.Data,
+	logger log.Logger) *ExamineeAnswerScoreUseCase {
+	return &ExamineeAnswerScoreUseCase{
+		repo:                     repo,
+		examineeAnswerRepo:       examineeAnswerRepo,
+		associationUc:            associationUc,
+		salesPaperUc:             salesPaperUc,
+		questionUc:               questionUc,
+		examineeQuestionAnswerUC: examineeQuestionAnswerUC,
+		examEvent:                examEvent,
+		defaultFormula:           c.StandardScoreFormulaConfig,
+		log:                      log.NewHelper(logger),
+	}
+}
+
+// CalculatePoints 对已提交的考试算分，成功进入已算分阶段，失败进入处理失败阶段并记录原因
+func (uc *ExamineeAnswerScoreUseCase) CalculatePoints(ctx context.Context, associationId string) (err error) {
+	l := uc.log.WithContext(ctx)
+	association, err := uc.associationUc.GetById(ctx, associationId)
+	if err != nil {
+		l.Errorf("CalculatePoints.associationUc.GetById Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if association == nil {
+		err = errors.New("考试记录不存在")
+		return
+	}
+	// 只有已提交、待人工评分（或上次处理失败需要重算）的考试才能算分
+	switch v1.StageNumber(association.StageNumber) {
+	case v1.StageNumber_Submit, v1.StageNumber_AwaitingGrading, v1.StageNumber_Failed:
+	default:
+		err = errors.New("考试状态异常")
+		return
+	}
+	examineeAnswer, err := uc.examineeAnswerRepo.GetByAssociationId(ctx, associationId)
+	if err != nil {
+		l.Errorf("CalculatePoints.examineeAnswerRepo.GetByAssociationId Failed, associationId:%v, err:%v", associationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if examineeAnswer == nil {
+		err = uc.associationUc.UpdateFailed(ctx, associationId, "答卷不存在")
+		return
+	}
+	// 文字题型先评分，还有问答题待人工评分时进入待评分阶段，评完后再算分
+	pending, reason, err := uc.gradeTextAnswers(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("CalculatePoints.gradeTextAnswers Failed, associationId:%v, reason:%v, err:%v", associationId, reason, err.Error())
+		if e := uc.associationUc.UpdateFailed(ctx, associationId, reason); e != nil {
+			l.Errorf("CalculatePoints.associationUc.UpdateFailed Failed, associationId:%v, err:%v", associationId, e.Error())
+		}
+		return
+	}
+	if pending > 0 {
+		err = uc.associationUc.UpdateStageNumber(ctx, associationId, v1.StageNumber_AwaitingGrading)
+		return
+	}
+	reason, err = uc.calculate(ctx, examineeAnswer)
+	if err != nil {
+		l.Errorf("CalculatePoints.calculate Failed, associationId:%v, reason:%v, err:%v", associationId, reason, err.Error())
+		if e := uc.associationUc.UpdateFailed(ctx, associationId, reason); e != nil {
+			l.Errorf("CalculatePoints.associationUc.UpdateFailed Failed, associationId:%v, err:%v", associationId, e.Error())
+		}
+		return
+	}
+	err = uc.associationUc.UpdateStageNumber(ctx, associationId, v1.StageNumber_CalculatePoints)
+	return
+}
+
+// calculate 按维度汇总原始分并写回维度得分和答卷总分，出错时返回失败原因
+func (uc *ExamineeAnswerScoreUseCase) calculate(ctx context.Context, examineeAnswer *entity.ExamineeAnswer) (reason string, err error) {
+	salesPaper, err := uc.salesPaperUc.GetSalesPaperDetail(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		reason = "获取试卷失败"
+		return
+	}
+	dimensions, err := uc.salesPaperUc.GetDimensionList(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		reason = "获取试卷维度失败"
+		return
+	}
+	questions, mQuestionOptions, err := uc.questionUc.GetQuestionsWithOptions(ctx, examineeAnswer.SalesPaperID)
+	if err != nil {
+		reason = "获取试卷题目失败"
+		return
+	}
+	// 按规则抽题的作答只对抽取的题目算分
+	if mQuestionId := attemptQuestionIds(examineeAnswer); mQuestionId != nil {
+		questions = slices.DeleteFunc(questions, func(question *entity.Question) bool {
+			_, ok := mQuestionId[question.ID]
+			return !ok
+		})
+	}
+	answers, err := uc.examineeQuestionAnswerUC.GetByExamineeAnswerId(ctx, examineeAnswer.ID)
+	if err != nil {
+		reason = "获取作答记录失败"
+		return
+	}
+	rawScores, err := uc.sumRawScores(questions, mQuestionOptions, answers)
+	if err != nil {
+		reason = "解析作答记录失败"
+		return
+	}
+	program, err := uc.getFormula(salesPaper)
+	if err != nil {
+		reason = "标准分公式无效"
+		return
+	}
+	// 试卷维度都要有得分记录，未作答的维度原始分为0
+	dimensionIds := make([]string, 0, len(dimensions))
+	mDimension := make(map[string]*entity.SalesPaperDimension, len(dimensions))
+	for _, dimension := range dimensions {
+		dimensionIds = append(dimensionIds, dimension.ID)
+		mDimension[dimension.ID] = dimension
+	}
+	for dimensionId := range rawScores {
+		if !slices.Contains(dimensionIds, dimensionId) {
+			dimensionIds = append(dimensionIds, dimensionId)
+		}
+	}
+	// 自适应作答保存各维度的能力估计
+	adaptive, err := parseAdaptiveState(examineeAnswer.AdaptiveState)
+	if err != nil {
+		reason = "解析自适应作答状态失败"
+		return
+	}
+	mAdaptive := make(map[string]*AdaptiveDimension, len(adaptive.Dimensions))
+	for _, dimension := range adaptive.Dimensions {
+		mAdaptive[dimension.DimensionID] = dimension
+	}
+	var (
+		scores     = make([]*entity.ExamineeAnswerDimensionScore, 0, len(dimensionIds))
+		totalScore float64
+	)
+	for _, dimensionId := range dimensionIds {
+		id, e := isnowflake.SnowFlake.NextID(_const.ExamineeAnswerDimensionScorePrefix)
+		if e != nil {
+			reason, err = "生成维度得分id失败", e
+			return
+		}
+		rawScore := rawScores[dimensionId]
+		standardScore, e := uc.standardScore(program, mDimension[dimensionId], rawScore)
+		if e != nil {
+			reason, err = "标准分计算失败", e
+			return
+		}
+		totalScore += standardScore
+		score := &entity.ExamineeAnswerDimensionScore{
+			ID:                     id,
+			ExamineeAnswerID:       examineeAnswer.ID,
+			DimensionID:            dimensionId,
+			DimensionRawScore:      rawScore,
+			DimensionStandardScore: standardScore,
+			CreatedBy:              "service",
+			UpdatedBy:              "service",
+		}
+		if dimension, ok := mAdaptive[dimensionId]; ok {
+			score.Theta, score.ThetaSe = dimension.Theta, dimension.SE
+		}
+		scores = append(scores, score)
+	}
+	// 不需要总分的试卷取各维度标准分的平均值
+	if !salesPaper.IsSumScore && len(scores) > 0 {
+		totalScore = totalScore / float64(len(scores))
+	}
+	if program != nil {
+		totalScore = iformula.Round(totalScore, program.Config().Rounding)
+	}
+	// 按考试事件分析作答诚信，得出作答有效性
+	events, err := uc.examEvent.GetByExamineeAnswerId(ctx, examineeAnswer.ID)
+	if err != nil {
+		reason = "获取考试事件失败"
+		return
+	}
+	thresholds, e := ParseIntegrityThresholds(salesPaper.IntegrityConfig)
+	if e != nil {
+		uc.log.WithContext(ctx).Errorf("calculate.ParseIntegrityThresholds Failed, salesPaperId:%v, err:%v", salesPaper.ID, e.Error())
+	}
+	integrity := AnalyzeIntegrity(examineeAnswer, events, thresholds)
+	flags, _ := json.Marshal(integrity.Flags)
+	if err = uc.repo.SaveScores(ctx, examineeAnswer.ID, scores); err != nil {
+		reason = "保存维度得分失败"
+		return
+	}
+	if err = uc.examineeAnswerRepo.UpdateResult(ctx, examineeAnswer.ID, totalScore, examineeAnswer.Comparability, integrity.Usability); err != nil {
+		reason = "保存答卷得分失败"
+		return
+	}
+	if err = uc.examineeAnswerRepo.UpdateIntegrity(ctx, examineeAnswer.ID, integrity.Score, string(flags)); err != nil {
+		reason = "保存作答诚信分析失败"
+		return
+	}
+	return
+}
+
+// sumRawScores 将作答的选项字母映射回选项分数，按维度累加原始分。选项绑定了维度时以选项维度为准，否则取题目维度；
+// 选项和题目都未绑定维度的得分不计入任何维度
+func (uc *ExamineeAnswerScoreUseCase) sumRawScores(questions []*entity.Question, mQuestionOptions map[string][]*entity.QuestionOption, answers []*entity.ExamineeAnswerQuestionAnswer) (map[string]float64, error) {
+	mQuestion := make(map[string]*entity.Question, len(questions))
+	for _, question := range questions {
+		mQuestion[question.ID] = question
+	}
+	rawScores := make(map[string]float64)
+	for _, answer := range answers {
+		question, ok := mQuestion[answer.QuestionID]
+		if !ok || answer.OptionSign == "" {
+			continue
+		}
+		// 文字题型的得分已在评分时写入答案
+		if isTextQuestion(v1.QuestionType(question.QuestionTypeID)) {
+			if question.DimensionID != "" {
+				rawScores[question.DimensionID] += answer.Score
+			}
+			continue
+		}
+		letters := make([]string, 0)
+		if err := json.Unmarshal([]byte(answer.OptionSign), &letters); err != nil {
+			return nil, err
+		}
+		options := mQuestionOptions[question.ID]
+		for i, letter := range letters {
+			option := findOptionByLetter(options, letter)
+			if option == nil {
+				continue
+			}
+			dimensionId := option.DimensionID
+			if dimensionId == "" {
+				dimensionId = question.DimensionID
+			}
+			if dimensionId == "" {
+				continue
+			}
+			switch v1.QuestionType(question.QuestionTypeID) {
+			case v1.QuestionType_Ranking:
+				// 排序题按名次加权：第1名得 选项分×选项数，之后每名递减一档
+				rawScores[dimensionId] += option.Score * float64(len(options)-i)
+			case v1.QuestionType_ForcedChoice:
+				// 迫选题最符合的选项加分，最不符合的选项减分
+				if i == 0 {
+					rawScores[dimensionId] += option.Score
+				} else {
+					rawScores[dimensionId] -= option.Score
+				}
+			default:
+				rawScores[dimensionId] += option.Score
+			}
+		}
+	}
+	return rawScores, nil
+}
+
+// findOptionByLetter 按选项字母找到题目选项，不存在时返回 nil
+func findOptionByLetter(options []*entity.QuestionOption, letter string) *entity.QuestionOption {
+	order := iutils.LetterToOrder(letter)
+	if order < 0 {
+		return nil
+	}
+	for _, option := range options {
+		if option.Order_ == order {
+			return option
+		}
+	}
+	return nil
+}
+
+// standardScore 按维度常模（平均分、标准差）将原始分换算为标准分，并限制在维度的分数上下限内。
+// 没有可用公式或维度未配置常模（标准差不大于0）时标准分等于原始分
+func (uc *ExamineeAnswerScoreUseCase) standardScore(program *iformula.Program, dimension *entity.SalesPaperDimension, rawScore float64) (float64, error) {
+	if dimension == nil {
+		return rawScore, nil
+	}
+	standardScore := rawScore
+	if program != nil && dimension.StandardMark > 0 {
+		score, err := program.Evaluate(rawScore, dimension.AverageMark, dimension.StandardMark)
+		if err != nil {
+			return 0, err
+		}
+		standardScore = score
+	}
+	// 上下限都为0表示未配置
+	if dimension.MaxScore > dimension.MinScore {
+		standardScore = math.Max(dimension.MinScore, math.Min(dimension.MaxScore, standardScore))
+	}
+	return standardScore, nil
+}
+
+// getFormula 获取试卷的标准分公式：优先使用试卷自身的公式，否则使用全局配置，都没有时返回nil。
+// 编译结果按试卷缓存，公式或小数位变化时重新编译
+func (uc *ExamineeAnswerScoreUseCase) getFormula(salesPaper *entity.SalesPaper) (*iformula.Program, error) {
+	config := iformula.ScoreFormulaConfig{Expression: salesPaper.Expression, Rounding: salesPaper.Rounding}
+	if config.Expression == "" && uc.defaultFormula != nil {
+		config = iformula.ScoreFormulaConfig{Expression: uc.defaultFormula.Expression, Rounding: int32(uc.defaultFormula.Rounding)}
+	}
+	if config.Expression == "" {
+		return nil, nil
+	}
+	if v, ok := uc.formulas.Load(salesPaper.ID); ok {
+		if program := v.(*iformula.Program); program.Config() == config {
+			return program, nil
+		}
+	}
+	program, err := iformula.Compile(&config)
+	if err != nil {
+		return nil, err
+	}
+	uc.formulas.Store(salesPaper.ID, program)
+	return program, nil
+}
@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"maps"
+	"testing"
+
+	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/data/entity"
+	"exam_api/internal/pkg/iformula"
+)
+
+func TestStandardScore(t *testing.T) {
+	program, err := iformula.Compile(&iformula.ScoreFormulaConfig{Expression: "50 + 10 * (raw_score - average_mark) / standard_mark", Rounding: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc := &ExamineeAnswerScoreUseCase{}
+	cases := []struct {
+		name      string
+		program   *iformula.Program
+		dimension *entity.SalesPaperDimension
+		raw       float64
+		want      float64
+	}{
+		{"按常模换算", program, &entity.SalesPaperDimension{AverageMark: 10, StandardMark: 5}, 15, 60},
+		{"未配置常模取原始分", program, &entity.SalesPaperDimension{AverageMark: 0, StandardMark: 0}, 12, 12},
+		{"标准差为负取原始分", program, &entity.SalesPaperDimension{StandardMark: -1}, 7, 7},
+		{"没有公式取原始分", nil, &entity.SalesPaperDimension{AverageMark: 10, StandardMark: 5}, 15, 15},
+		{"维度不存在取原始分", program, nil, 3, 3},
+		{"限制在上限内", program, &entity.SalesPaperDimension{AverageMark: 10, StandardMark: 5, MinScore: 0, MaxScore: 55}, 15, 55},
+		{"限制在下限内", program, &entity.SalesPaperDimension{AverageMark: 10, StandardMark: 5, MinScore: 45, MaxScore: 100}, 0, 45},
+	}
+	for _, c := range cases {
+		got, err := uc.standardScore(c.program, c.dimension, c.raw)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestSumRawScores(t *testing.T) {
+	questions := []*entity.Question{
+		{ID: "Q1", DimensionID: "D1", QuestionTypeID: int32(v1.QuestionType_RadioChoice)},
+		{ID: "Q2", DimensionID: "D1", QuestionTypeID: int32(v1.QuestionType_MultipleChoice)},
+		{ID: "Q3", DimensionID: "D1", QuestionTypeID: int32(v1.QuestionType_Ranking)},
+		{ID: "Q4", DimensionID: "D1", QuestionTypeID: int32(v1.QuestionType_ForcedChoice)},
+		{ID: "Q5", DimensionID: "D2", QuestionTypeID: int32(v1.QuestionType_FillIn)},
+		{ID: "Q6", QuestionTypeID: int32(v1.QuestionType_RadioChoice)},
+		{ID: "Q7", QuestionTypeID: int32(v1.QuestionType_FillIn)},
+	}
+	options := func(questionId string, scores []float64, dimensions ...string) []*entity.QuestionOption {
+		res := make([]*entity.QuestionOption, 0, len(scores))
+		for i, score := range scores {
+			option := &entity.QuestionOption{QuestionID: questionId, Score: score, Order_: int32(i)}
+			if i < len(dimensions) {
+				option.DimensionID = dimensions[i]
+			}
+			res = append(res, option)
+		}
+		return res
+	}
+	mQuestionOptions := map[string][]*entity.QuestionOption{
+		"Q1": options("Q1", []float64{1, 2, 3}),
+		"Q2": options("Q2", []float64{1, 2, 3}),
+		"Q3": options("Q3", []float64{1, 2, 3}),
+		// 迫选题每个选项绑定不同维度
+		"Q4": options("Q4", []float64{1, 1, 1, 1}, "DA", "DB", "DC", "DD"),
+		// 题目未绑定维度，只有部分选项绑定
+		"Q6": options("Q6", []float64{1, 2}, "D3"),
+	}
+	answer := func(questionId, sign string) *entity.ExamineeAnswerQuestionAnswer {
+		return &entity.ExamineeAnswerQuestionAnswer{QuestionID: questionId, OptionSign: sign}
+	}
+	cases := []struct {
+		name    string
+		answers []*entity.ExamineeAnswerQuestionAnswer
+		want    map[string]float64
+	}{
+		{"单选题取所选选项分", []*entity.ExamineeAnswerQuestionAnswer{answer("Q1", `["C"]`)}, map[string]float64{"D1": 3}},
+		{"多选题累加所选选项分", []*entity.ExamineeAnswerQuestionAnswer{answer("Q2", `["A","C"]`)}, map[string]float64{"D1": 4}},
+		// 第1名×3、第2名×2、第3名×1：C(3)×3 + A(1)×2 + B(2)×1
+		{"排序题按名次加权", []*entity.ExamineeAnswerQuestionAnswer{answer("Q3", `["C","A","B"]`)}, map[string]float64{"D1": 13}},
+		{"迫选题最符合加分最不符合减分", []*entity.ExamineeAnswerQuestionAnswer{answer("Q4", `["B","D"]`)}, map[string]float64{"DB": 1, "DD": -1}},
+		{"文字题取评分结果", []*entity.ExamineeAnswerQuestionAnswer{{QuestionID: "Q5", OptionSign: "[]", Score: 5}}, map[string]float64{"D2": 5}},
+		{"不存在的选项忽略", []*entity.ExamineeAnswerQuestionAnswer{answer("Q1", `["Z"]`)}, map[string]float64{}},
+		{"不属于试卷的题目忽略", []*entity.ExamineeAnswerQuestionAnswer{answer("QX", `["A"]`)}, map[string]float64{}},
+		{"选项维度优先于空的题目维度", []*entity.ExamineeAnswerQuestionAnswer{answer("Q6", `["A"]`)}, map[string]float64{"D3": 1}},
+		{"选项和题目都无维度时忽略", []*entity.ExamineeAnswerQuestionAnswer{answer("Q6", `["B"]`)}, map[string]float64{}},
+		{"无维度的文字题忽略", []*entity.ExamineeAnswerQuestionAnswer{{QuestionID: "Q7", OptionSign: "[]", Score: 5}}, map[string]float64{}},
+		{"未作答忽略", []*entity.ExamineeAnswerQuestionAnswer{answer("Q1", "")}, map[string]float64{}},
+		{
+			"多题累加到同一维度",
+			[]*entity.ExamineeAnswerQuestionAnswer{answer("Q1", `["A"]`), answer("Q2", `["B"]`)},
+			map[string]float64{"D1": 3},
+		},
+	}
+	uc := &ExamineeAnswerScoreUseCase{}
+	for _, c := range cases {
+		got, err := uc.sumRawScores(questions, mQuestionOptions, c.answers)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !maps.Equal(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+	if _, err := uc.sumRawScores(questions, mQuestionOptions, []*entity.ExamineeAnswerQuestionAnswer{answer("Q1", "{")}); err == nil {
+		t.Error("invalid option sign: want error")
+	}
+}
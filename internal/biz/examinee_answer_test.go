@@ -0,0 +1,421 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "exam_api/api/exam_api/v1"
+	_const "exam_api/internal/const"
+	"exam_api/internal/data/entity"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestCanViewTimeline(t *testing.T) {
+	association := &entity.ExamineeSalesPaperAssociation{ExamineeID: "EP1", CreatedBy: "A1"}
+	cases := []struct {
+		name   string
+		userId string
+		role   string
+		want   bool
+	}{
+		{"考生回放自己的考试", "EP1", "", true},
+		{"考生回放他人的考试", "EP2", "", false},
+		{"核查人员回放任意考试", "A9", _const.RoleInvestigator, true},
+		{"招聘人员回放自己安排的考试", "A1", _const.RoleRecruiter, true},
+		{"招聘人员回放他人安排的考试", "A2", _const.RoleRecruiter, false},
+		{"阅卷人员不能回放", "A1", _const.RoleGrader, false},
+	}
+	for _, c := range cases {
+		if got := canViewTimeline(association, c.userId, c.role); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRemainingSeconds(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	cases := []struct {
+		name       string
+		lastAction time.Time
+		remaining  int32
+		want       int32
+	}{
+		{"从未心跳不扣时间", time.Time{}, 600, 600},
+		{"间隔内按实际耗时扣除", now.Add(-10 * time.Second), 600, 590},
+		{"短暂断线最多扣 30 秒", now.Add(-10 * time.Minute), 1200, 1170},
+		{"剩余时间不足 30 秒时断线即用完", now.Add(-10 * time.Minute), 20, 0},
+		{"离开超过剩余时长即用完", now.Add(-15 * time.Minute), 600, 0},
+		{"离开恰好到剩余时长即用完", now.Add(-10 * time.Minute), 600, 0},
+		{"时钟回拨不增加剩余时间", now.Add(5 * time.Second), 600, 600},
+	}
+	for _, c := range cases {
+		examineeAnswer := &entity.ExamineeAnswer{LastActionTime: c.lastAction, RemainingTimelimit: c.remaining}
+		if !c.lastAction.IsZero() {
+			examineeAnswer.DueAt = c.lastAction.Add(time.Duration(c.remaining) * time.Second)
+		}
+		if got := remainingSeconds(examineeAnswer, now); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// fakeRedis 只实现会话校验用到的 redis 操作
+type fakeRedis struct {
+	RedisRepository
+	values map[string]string
+	ttls   map[string]int64
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) (string, error) {
+	if v, ok := r.values[key]; ok {
+		return v, nil
+	}
+	return "", redis.Nil
+}
+
+func (r *fakeRedis) SetNX(ctx context.Context, key string, value string, expiration time.Duration) (bool, error) {
+	if _, ok := r.values[key]; ok {
+		return false, nil
+	}
+	r.values[key] = value
+	return true, nil
+}
+
+func (r *fakeRedis) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return nil
+}
+
+// Eval 按脚本语义模拟计数加1并补设过期时间，ttls 记录各 key 的过期秒数
+func (r *fakeRedis) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	if script != _const.IncrWithExpireScript {
+		return nil, fmt.Errorf("unexpected script")
+	}
+	count, _ := strconv.ParseInt(r.values[keys[0]], 10, 64)
+	count++
+	r.values[keys[0]] = strconv.FormatInt(count, 10)
+	if _, ok := r.ttls[keys[0]]; !ok {
+		r.ttls[keys[0]] = args[0].(int64)
+	}
+	return count, nil
+}
+
+func TestCheckActiveSession(t *testing.T) {
+	activeKey := fmt.Sprintf(_const.RedisActiveSessionKey, "ESPA1")
+	latestKey := fmt.Sprintf(_const.RedisLatestSessionKey, "ESPA1")
+	cases := []struct {
+		name      string
+		values    map[string]string
+		sessionId string
+		want      bool
+	}{
+		{"当前在线会话", map[string]string{activeKey: "S2", latestKey: latestSessionValue("S2", "fp")}, "S2", true},
+		{"被顶替的会话", map[string]string{activeKey: "S2", latestKey: latestSessionValue("S2", "fp")}, "S1", false},
+		{"最近签发的会话空闲过期后重新上线", map[string]string{latestKey: latestSessionValue("S2", "fp")}, "S2", true},
+		{"被顶替的会话不能在空闲过期后接管", map[string]string{latestKey: latestSessionValue("S2", "fp")}, "S1", false},
+		{"交卷后会话全部失效", map[string]string{}, "S2", false},
+	}
+	for _, c := range cases {
+		uc := &ExamineeAnswerUseCase{redisRepo: &fakeRedis{values: c.values}}
+		got, err := uc.CheckActiveSession(context.Background(), "ESPA1", c.sessionId)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+		if got && c.values[activeKey] != c.sessionId {
+			t.Errorf("%s: active session = %q, want %q", c.name, c.values[activeKey], c.sessionId)
+		}
+	}
+}
+
+func TestCheckClientEvents(t *testing.T) {
+	event := func(eventType string, meta map[string]string) *v1.ClientExamEvent {
+		return &v1.ClientExamEvent{EventType: eventType, Meta: meta}
+	}
+	manyKeys := make(map[string]string, reportEventMetaKeys+1)
+	for i := 0; i <= reportEventMetaKeys; i++ {
+		manyKeys[fmt.Sprintf("k%d", i)] = "v"
+	}
+	cases := []struct {
+		name    string
+		events  []*v1.ClientExamEvent
+		wantErr bool
+	}{
+		{"合法事件", []*v1.ClientExamEvent{event(string(_const.ExamEventCopy), map[string]string{"length": "12"})}, false},
+		{"不支持的事件类型", []*v1.ClientExamEvent{event(string(_const.ExamEventSubmit), nil)}, true},
+		{"附加信息项数超限", []*v1.ClientExamEvent{event(string(_const.ExamEventCopy), manyKeys)}, true},
+		{"附加信息字节数超限", []*v1.ClientExamEvent{event(string(_const.ExamEventPaste), map[string]string{"text": strings.Repeat("x", reportEventMetaBytes)})}, true},
+		{"单次条数超限", make([]*v1.ClientExamEvent, reportEventBatchSize+1), true},
+	}
+	for _, c := range cases {
+		if err := checkClientEvents(c.events); (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestCheckAnswer(t *testing.T) {
+	question := func(questionType v1.QuestionType, letters ...string) *v1.QuestionData {
+		options := make([]*v1.QuestionOptionData, 0, len(letters))
+		for _, letter := range letters {
+			options = append(options, &v1.QuestionOptionData{SerialNumber: letter})
+		}
+		return &v1.QuestionData{QuestionId: "Q1", QuestionTypeId: questionType, QuestionOptionsData: options}
+	}
+	selected := func(letters ...string) *v1.QuestionAnswerData {
+		return &v1.QuestionAnswerData{QuestionId: "Q1", OptionsSerialNumberData: letters}
+	}
+	cases := []struct {
+		name     string
+		question *v1.QuestionData
+		answer   *v1.QuestionAnswerData
+		want     string
+	}{
+		{"题目不属于本次作答", nil, selected("A"), AnswerQuestionNotInPaper},
+		{"单选题选一个", question(v1.QuestionType_RadioChoice, "A", "B"), selected("B"), ""},
+		{"单选题未选", question(v1.QuestionType_RadioChoice, "A", "B"), selected(), AnswerSingleOption},
+		{"单选题选多个", question(v1.QuestionType_RadioChoice, "A", "B"), selected("A", "B"), AnswerSingleOption},
+		{"判断题选多个", question(v1.QuestionType_Judge, "A", "B"), selected("A", "B"), AnswerSingleOption},
+		{"量表题选一个", question(v1.QuestionType_Likert, "A", "B", "C", "D", "E"), selected("C"), ""},
+		{"选项不存在", question(v1.QuestionType_RadioChoice, "A", "B"), selected("C"), AnswerOptionNotExist},
+		{"多选题选多个", question(v1.QuestionType_MultipleChoice, "A", "B", "C"), selected("A", "C"), ""},
+		{"多选题未选", question(v1.QuestionType_MultipleChoice, "A", "B", "C"), selected(), ""},
+		{"多选题选项重复", question(v1.QuestionType_MultipleChoice, "A", "B", "C"), selected("A", "A"), AnswerOptionDuplicate},
+		{"排序题全部排序", question(v1.QuestionType_Ranking, "A", "B", "C"), selected("C", "A", "B"), ""},
+		{"排序题未作答", question(v1.QuestionType_Ranking, "A", "B", "C"), selected(), ""},
+		{"排序题部分排序", question(v1.QuestionType_Ranking, "A", "B", "C"), selected("C", "A"), AnswerRankingIncomplete},
+		{"迫选题选出两项", question(v1.QuestionType_ForcedChoice, "A", "B", "C", "D"), selected("A", "D"), ""},
+		{"迫选题最符合和最不符合相同", question(v1.QuestionType_ForcedChoice, "A", "B", "C", "D"), selected("A", "A"), AnswerOptionDuplicate},
+		{"迫选题只选一项", question(v1.QuestionType_ForcedChoice, "A", "B", "C", "D"), selected("A"), AnswerForcedChoice},
+		{"数值题合法数字", question(v1.QuestionType_Numeric), &v1.QuestionAnswerData{TextAnswer: " -3.5 "}, ""},
+		{"数值题不是数字", question(v1.QuestionType_Numeric), &v1.QuestionAnswerData{TextAnswer: "abc"}, AnswerNumericInvalid},
+		{"数值题未作答", question(v1.QuestionType_Numeric), &v1.QuestionAnswerData{}, ""},
+		{"问答题任意文字", question(v1.QuestionType_Essay), &v1.QuestionAnswerData{TextAnswer: "abc"}, ""},
+	}
+	for _, c := range cases {
+		if got := checkAnswer(c.question, c.answer); got != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestEncodeDecodeAnswer(t *testing.T) {
+	cases := []struct {
+		name         string
+		questionType v1.QuestionType
+		answer       *v1.QuestionAnswerData
+		want         []string
+	}{
+		{"单选题", v1.QuestionType_RadioChoice, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"B"}}, []string{"B"}},
+		{"多选题", v1.QuestionType_MultipleChoice, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"A", "C"}}, []string{"A", "C"}},
+		{"量表题", v1.QuestionType_Likert, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"E"}}, []string{"E"}},
+		{"排序题按名次", v1.QuestionType_Ranking, &v1.QuestionAnswerData{RankingSerialNumberData: []string{"C", "A", "B"}}, []string{"C", "A", "B"}},
+		{"迫选题", v1.QuestionType_ForcedChoice, &v1.QuestionAnswerData{MostSerialNumber: "A", LeastSerialNumber: "D"}, []string{"A", "D"}},
+		{"迫选题未作答", v1.QuestionType_ForcedChoice, &v1.QuestionAnswerData{}, []string{}},
+		{"文字题没有选项", v1.QuestionType_FillIn, &v1.QuestionAnswerData{OptionsSerialNumberData: []string{"A"}, TextAnswer: "x"}, []string{}},
+	}
+	for _, c := range cases {
+		got := encodeAnswer(c.questionType, c.answer)
+		if !slices.Equal(got, c.want) {
+			t.Errorf("%s: encode = %v, want %v", c.name, got, c.want)
+			continue
+		}
+		if isTextQuestion(c.questionType) {
+			continue
+		}
+		// 解码后重新编码得到相同的字母列表
+		decoded := &v1.QuestionAnswerData{}
+		decodeAnswer(c.questionType, got, decoded)
+		if again := encodeAnswer(c.questionType, decoded); !slices.Equal(again, c.want) {
+			t.Errorf("%s: decode then encode = %v, want %v", c.name, again, c.want)
+		}
+	}
+}
+
+// fakeAssociationRepo 只实现重新算分用到的考试查询，GetById 记录被算分的考试，now 为重新算分次数累加时的更新时间
+type fakeAssociationRepo struct {
+	ExamineeSalesPaperAssociationRepo
+	rows   []*entity.ExamineeSalesPaperAssociation
+	scored []string
+	now    time.Time
+}
+
+func (r *fakeAssociationRepo) GetRescoreList(ctx context.Context, before time.Time, maxTimes int32, afterId string, limit int) ([]*entity.ExamineeSalesPaperAssociation, error) {
+	list := make([]*entity.ExamineeSalesPaperAssociation, 0)
+	for _, row := range r.rows {
+		switch v1.StageNumber(row.StageNumber) {
+		case v1.StageNumber_Submit, v1.StageNumber_AwaitingGrading, v1.StageNumber_Failed:
+		default:
+			continue
+		}
+		if row.UpdatedAt.Before(before) && row.RescoreTimes < maxTimes && row.ID > afterId {
+			copied := *row
+			list = append(list, &copied)
+		}
+	}
+	slices.SortFunc(list, func(a, b *entity.ExamineeSalesPaperAssociation) int { return strings.Compare(a.ID, b.ID) })
+	return list[:min(limit, len(list))], nil
+}
+
+func (r *fakeAssociationRepo) IncrRescoreTimes(ctx context.Context, id string) error {
+	for _, row := range r.rows {
+		if row.ID == id {
+			row.RescoreTimes++
+			row.UpdatedAt = r.now
+		}
+	}
+	return nil
+}
+
+func (r *fakeAssociationRepo) GetById(ctx context.Context, id string) (*entity.ExamineeSalesPaperAssociation, error) {
+	r.scored = append(r.scored, id)
+	return nil, nil
+}
+
+// fakeRescoreAnswerRepo 作答id为 EA+关联id
+type fakeRescoreAnswerRepo struct {
+	ExamineeAnswerRepo
+}
+
+func (r *fakeRescoreAnswerRepo) GetByAssociationId(ctx context.Context, associationId string) (*entity.ExamineeAnswer, error) {
+	return &entity.ExamineeAnswer{ID: "EA" + associationId, ExamineeSalesPaperAssociationID: associationId}, nil
+}
+
+// fakePendingGradingRepo 按作答id返回待人工评分的数量
+type fakePendingGradingRepo struct {
+	ExamineeQuestionAnswerRepo
+	pending map[string]int64
+}
+
+func (r *fakePendingGradingRepo) CountPendingGrading(ctx context.Context, examineeAnswerId string) (int64, error) {
+	return r.pending[examineeAnswerId], nil
+}
+
+func newRescoreUseCase(repo *fakeAssociationRepo, pending map[string]int64) *ExamineeAnswerUseCase {
+	associationUc := &ExamineeSalesPaperAssociationUseCase{repo: repo, log: log.NewHelper(log.DefaultLogger)}
+	return &ExamineeAnswerUseCase{
+		repo:                     &fakeRescoreAnswerRepo{},
+		associationUc:            associationUc,
+		examineeQuestionAnswerUC: &ExamineeQuestionAnswerUseCase{repo: &fakePendingGradingRepo{pending: pending}, log: log.NewHelper(log.DefaultLogger)},
+		scoreUc:                  &ExamineeAnswerScoreUseCase{associationUc: associationUc, log: log.NewHelper(log.DefaultLogger)},
+		log:                      log.NewHelper(log.DefaultLogger),
+	}
+}
+
+func TestRescore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	row := func(id string, stage v1.StageNumber, age time.Duration) *entity.ExamineeSalesPaperAssociation {
+		return &entity.ExamineeSalesPaperAssociation{ID: id, StageNumber: int32(stage), UpdatedAt: now.Add(-age)}
+	}
+	many := make([]*entity.ExamineeSalesPaperAssociation, 0)
+	manyIds := make([]string, 0)
+	for i := 0; i < sweepBatchSize+20; i++ {
+		id := fmt.Sprintf("ESPA%03d", i)
+		many = append(many, row(id, v1.StageNumber_Failed, time.Hour))
+		manyIds = append(manyIds, id)
+	}
+	exhausted := row("ESPA2", v1.StageNumber_Failed, time.Hour)
+	exhausted.RescoreTimes = maxRescoreTimes
+	cases := []struct {
+		name    string
+		rows    []*entity.ExamineeSalesPaperAssociation
+		pending map[string]int64
+		want    []string
+	}{
+		{
+			"已提交未算分和处理失败的考试重新算分",
+			[]*entity.ExamineeSalesPaperAssociation{row("ESPA1", v1.StageNumber_Submit, time.Hour), row("ESPA2", v1.StageNumber_Failed, time.Hour)},
+			nil,
+			[]string{"ESPA1", "ESPA2"},
+		},
+		{
+			"刚提交或刚失败的考试等下一周期",
+			[]*entity.ExamineeSalesPaperAssociation{row("ESPA1", v1.StageNumber_Submit, time.Minute), row("ESPA2", v1.StageNumber_Failed, rescoreDelay-time.Second)},
+			nil,
+			nil,
+		},
+		{
+			"其他阶段不算分",
+			[]*entity.ExamineeSalesPaperAssociation{row("ESPA1", v1.StageNumber_InProgress, time.Hour), row("ESPA2", v1.StageNumber_CalculatePoints, time.Hour)},
+			nil,
+			nil,
+		},
+		{
+			"待人工评分的考试全部评完后算分",
+			[]*entity.ExamineeSalesPaperAssociation{row("ESPA1", v1.StageNumber_AwaitingGrading, time.Hour), row("ESPA2", v1.StageNumber_AwaitingGrading, time.Hour)},
+			map[string]int64{"EAESPA1": 1},
+			[]string{"ESPA2"},
+		},
+		{
+			"达到重试次数上限后不再算分",
+			[]*entity.ExamineeSalesPaperAssociation{row("ESPA1", v1.StageNumber_Failed, time.Hour), exhausted},
+			nil,
+			[]string{"ESPA1"},
+		},
+		{"超过一页时翻页处理", many, nil, manyIds},
+	}
+	for _, c := range cases {
+		repo := &fakeAssociationRepo{rows: c.rows, now: now}
+		if err := newRescoreUseCase(repo, c.pending).rescore(context.Background(), now); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !slices.Equal(repo.scored, c.want) {
+			t.Errorf("%s: rescored %v, want %v", c.name, repo.scored, c.want)
+		}
+	}
+}
+
+// 一直失败的考试每 rescoreDelay 最多重试一次，达到 maxRescoreTimes 次后停止
+func TestRescoreStopsAfterMaxTimes(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	repo := &fakeAssociationRepo{rows: []*entity.ExamineeSalesPaperAssociation{{ID: "ESPA1", StageNumber: int32(v1.StageNumber_Failed), UpdatedAt: now.Add(-time.Hour)}}}
+	uc := newRescoreUseCase(repo, nil)
+	// 扫描每 30 秒一次，10 分钟内只在第 0 秒和超过 rescoreDelay 后的第 330 秒重试
+	for i := 0; i < 20; i++ {
+		repo.now = now.Add(time.Duration(i) * 30 * time.Second)
+		if err := uc.rescore(context.Background(), repo.now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(repo.scored) != 2 {
+		t.Fatalf("retried %d times within 10 minutes, want 2", len(repo.scored))
+	}
+	for i := 0; i < 100; i++ {
+		repo.now = repo.now.Add(rescoreDelay + time.Second)
+		_ = uc.rescore(context.Background(), repo.now)
+	}
+	if len(repo.scored) != maxRescoreTimes || repo.rows[0].RescoreTimes != maxRescoreTimes {
+		t.Fatalf("retried %d times after cap, want %d", len(repo.scored), maxRescoreTimes)
+	}
+}
+
+func TestIncrWithExpire(t *testing.T) {
+	cases := []struct {
+		name      string
+		values    map[string]string
+		ttls      map[string]int64
+		wantCount int64
+		wantTTL   int64
+	}{
+		{"首次计数设置过期时间", map[string]string{}, map[string]int64{}, 1, 60},
+		{"已有过期时间时不延长", map[string]string{"k": "3"}, map[string]int64{"k": 10}, 4, 10},
+		{"过期时间丢失时补设", map[string]string{"k": "3"}, map[string]int64{}, 4, 60},
+	}
+	for _, c := range cases {
+		redisRepo := &fakeRedis{values: c.values, ttls: c.ttls}
+		count, err := incrWithExpire(context.Background(), redisRepo, "k", time.Minute)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if count != c.wantCount || redisRepo.ttls["k"] != c.wantTTL {
+			t.Errorf("%s: got (%d, ttl %d), want (%d, ttl %d)", c.name, count, redisRepo.ttls["k"], c.wantCount, c.wantTTL)
+		}
+	}
+}
@@ -4,13 +4,27 @@ import (
 	"context"
 	"exam_api/internal/data/entity"
 	"github.com/go-kratos/kratos/v2/log"
+	"time"
 )
 
 type ExamineeQuestionAnswerRepo interface {
 	GetByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamineeAnswerQuestionAnswer, err error)
-	SaveAnswer(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer) error
+	SaveAnswer(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer, revisions []*entity.ExamineeAnswerQuestionRevision) error
+	GetRevisionsByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamineeAnswerQuestionRevision, err error)
+	GetById(ctx context.Context, id string) (resEntity *entity.ExamineeAnswerQuestionAnswer, err error)
+	UpdateGrades(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer) error
+	UpdateManualGrade(ctx context.Context, id string, score float64, gradedBy string, gradedAt time.Time) (bool, error)
+	GetPendingGradingPageList(ctx context.Context, pageIndex, pageSize int32) (list []*entity.ExamineeAnswerQuestionAnswer, total int64, err error)
+	CountPendingGrading(ctx context.Context, examineeAnswerId string) (count int64, err error)
 }
 
+// 答案评分状态
+const (
+	GradeStatusNone    int32 = 0 // 无需人工评分（选择题或自动评分）
+	GradeStatusPending int32 = 1 // 待人工评分
+	GradeStatusGraded  int32 = 2 // 已人工评分
+)
+
 type ExamineeQuestionAnswerUseCase struct {
 	repo ExamineeQuestionAnswerRepo
 	log  *log.Helper
@@ -27,6 +41,30 @@ func (uc *ExamineeQuestionAnswerUseCase) GetByExamineeAnswerId(ctx context.Conte
 	return uc.repo.GetByExamineeAnswerId(ctx, examineeAnswerId)
 }
 
-func (uc *ExamineeQuestionAnswerUseCase) SaveAnswer(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer) error {
-	return uc.repo.SaveAnswer(ctx, answers)
+func (uc *ExamineeQuestionAnswerUseCase) SaveAnswer(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer, revisions []*entity.ExamineeAnswerQuestionRevision) error {
+	return uc.repo.SaveAnswer(ctx, answers, revisions)
+}
+
+func (uc *ExamineeQuestionAnswerUseCase) GetRevisionsByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamineeAnswerQuestionRevision, err error) {
+	return uc.repo.GetRevisionsByExamineeAnswerId(ctx, examineeAnswerId)
+}
+
+func (uc *ExamineeQuestionAnswerUseCase) GetById(ctx context.Context, id string) (resEntity *entity.ExamineeAnswerQuestionAnswer, err error) {
+	return uc.repo.GetById(ctx, id)
+}
+
+func (uc *ExamineeQuestionAnswerUseCase) UpdateGrades(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer) error {
+	return uc.repo.UpdateGrades(ctx, answers)
+}
+
+func (uc *ExamineeQuestionAnswerUseCase) UpdateManualGrade(ctx context.Context, id string, score float64, gradedBy string, gradedAt time.Time) (bool, error) {
+	return uc.repo.UpdateManualGrade(ctx, id, score, gradedBy, gradedAt)
+}
+
+func (uc *ExamineeQuestionAnswerUseCase) GetPendingGradingPageList(ctx context.Context, pageIndex, pageSize int32) (list []*entity.ExamineeAnswerQuestionAnswer, total int64, err error) {
+	return uc.repo.GetPendingGradingPageList(ctx, pageIndex, pageSize)
+}
+
+func (uc *ExamineeQuestionAnswerUseCase) CountPendingGrading(ctx context.Context, examineeAnswerId string) (count int64, err error) {
+	return uc.repo.CountPendingGrading(ctx, examineeAnswerId)
 }
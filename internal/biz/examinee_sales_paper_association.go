@@ -2,13 +2,13 @@ package biz
 
 import (
 	"context"
-	"errors"
 	v1 "exam_api/api/exam_api/v1"
 	"exam_api/internal/data/entity"
 	"exam_api/internal/model"
 	"exam_api/internal/pkg/icontext"
 	innErr "exam_api/internal/pkg/ierrors"
 	"github.com/go-kratos/kratos/v2/log"
+	"time"
 )
 
 type ExamineeSalesPaperAssociationRepo interface {
@@ -17,24 +17,24 @@ type ExamineeSalesPaperAssociationRepo interface {
 	GetByExamineeIds(ctx context.Context, examineeIds []string) (list []*entity.ExamineeSalesPaperAssociation, err error)
 	GetById(ctx context.Context, id string) (resEntity *entity.ExamineeSalesPaperAssociation, err error)
 	UpdateStageNumber(ctx context.Context, examineeSalesPaperAssociationId string, stageNumber v1.StageNumber) (err error)
+	UpdateFailed(ctx context.Context, examineeSalesPaperAssociationId string, reason string) (err error)
+	GetRescoreList(ctx context.Context, before time.Time, maxTimes int32, afterId string, limit int) (list []*entity.ExamineeSalesPaperAssociation, err error)
+	IncrRescoreTimes(ctx context.Context, examineeSalesPaperAssociationId string) (err error)
 }
 
 type ExamineeSalesPaperAssociationUseCase struct {
-	repo            ExamineeSalesPaperAssociationRepo
-	salesPaperCase  *SalesPaperUseCase
-	questionUseCase *QuestionUseCase
-	log             *log.Helper
+	repo           ExamineeSalesPaperAssociationRepo
+	salesPaperCase *SalesPaperUseCase
+	log            *log.Helper
 }
 
 func NewExamineeSalesPaperAssociationUseCase(repo ExamineeSalesPaperAssociationRepo,
 	salesPaperCase *SalesPaperUseCase,
-	questionUseCase *QuestionUseCase,
 	logger log.Logger) *ExamineeSalesPaperAssociationUseCase {
 	return &ExamineeSalesPaperAssociationUseCase{
-		repo:            repo,
-		salesPaperCase:  salesPaperCase,
-		questionUseCase: questionUseCase,
-		log:             log.NewHelper(logger),
+		repo:           repo,
+		salesPaperCase: salesPaperCase,
+		log:            log.NewHelper(logger),
 	}
 }
 
@@ -56,6 +56,14 @@ func (uc *ExamineeSalesPaperAssociationUseCase) GetExamPageList(ctx context.Cont
 		return
 	}
 	resp.Total = total
+	salesPaperIds := make([]string, 0, len(res))
+	for _, re := range res {
+		salesPaperIds = append(salesPaperIds, re.SalesPaperID)
+	}
+	mSalesPaper, err := uc.salesPaperCase.GetSalesPaperMap(ctx, salesPaperIds)
+	if err != nil {
+		return
+	}
 	for _, re := range res {
 		var status int32 = 1
 		if re.StageNumber >= int32(v1.StageNumber_Submit) {
@@ -65,36 +73,46 @@ func (uc *ExamineeSalesPaperAssociationUseCase) GetExamPageList(ctx context.Cont
 			ExamineeAssociationId: re.ID,
 			SalesPaperName:        re.SalesPaperName,
 			ExamStatus:            status,
+			TimeLimit:             re.TimeLimit,
+		}
+		if re.OpenAt != nil {
+			cur.OpenAt = re.OpenAt.Format(time.DateTime)
+		}
+		if re.CloseAt != nil {
+			cur.CloseAt = re.CloseAt.Format(time.DateTime)
+		}
+		if salesPaper := mSalesPaper[re.SalesPaperID]; salesPaper != nil {
+			if cur.TimeLimit <= 0 {
+				cur.TimeLimit = salesPaper.RecommendTimeLim
+			}
+			cur.IsPractice = salesPaper.IsPractice
 		}
 		resp.ExamList = append(resp.ExamList, cur)
 	}
 	return
 }
 
-func (uc *ExamineeSalesPaperAssociationUseCase) ExamQuestion(ctx context.Context, req *v1.ExamQuestionRequest) (resp *v1.ExamQuestionResponse, err error) {
-	l := uc.log.WithContext(ctx)
-	associationId, _ := icontext.AssociationIdFrom(ctx)
-	// 根据关联id获取试卷id
-	association, err := uc.repo.GetById(ctx, associationId)
-	if err != nil {
-		l.Errorf("ExamQuestion.repo.GetById Failed, associationId:%v, err:%v", associationId, err.Error())
-		err = innErr.ErrInternalServer
+// CheckWindow 检查当前时间是否在考试开放窗口内，未开放和已关闭分别返回不同的错误
+func (uc *ExamineeSalesPaperAssociationUseCase) CheckWindow(association *entity.ExamineeSalesPaperAssociation, now time.Time) (err error) {
+	if association.OpenAt != nil && now.Before(*association.OpenAt) {
+		err = innErr.WithMessage(innErr.ErrExamNotOpen, "考试尚未开放，开放时间："+association.OpenAt.Format(time.DateTime))
 		return
 	}
-	if association == nil {
-		err = errors.New("试卷不存在")
-		return
-	}
-	//获取试卷题目
-	resp, err = uc.questionUseCase.ExamQuestion(ctx, association.SalesPaperID)
-	if err != nil {
-		l.Errorf("ExamQuestion.questionUseCase.ExamQuestion Failed, req:%v, salesPaperId:%v, err:%v", req, association.SalesPaperID, err.Error())
-		err = innErr.ErrInternalServer
+	if association.CloseAt != nil && !now.Before(*association.CloseAt) {
+		err = innErr.ErrExamClosed
 		return
 	}
 	return
 }
 
+// GetTimeLimit 获取考试时长（分钟），关联未单独设置时使用试卷建议时长
+func (uc *ExamineeSalesPaperAssociationUseCase) GetTimeLimit(association *entity.ExamineeSalesPaperAssociation, salesPaper *entity.SalesPaper) int32 {
+	if association.TimeLimit > 0 {
+		return association.TimeLimit
+	}
+	return salesPaper.RecommendTimeLim
+}
+
 func (uc *ExamineeSalesPaperAssociationUseCase) GetById(ctx context.Context, id string) (resEntity *entity.ExamineeSalesPaperAssociation, err error) {
 	return uc.repo.GetById(ctx, id)
 }
@@ -109,3 +127,37 @@ func (uc *ExamineeSalesPaperAssociationUseCase) UpdateStageNumber(ctx context.Co
 	}
 	return
 }
+
+func (uc *ExamineeSalesPaperAssociationUseCase) UpdateFailed(ctx context.Context, examineeSalesPaperAssociationId string, reason string) (err error) {
+	l := uc.log.WithContext(ctx)
+	err = uc.repo.UpdateFailed(ctx, examineeSalesPaperAssociationId, reason)
+	if err != nil {
+		l.Errorf("UpdateFailed.repo.UpdateFailed Failed, examineeSalesPaperAssociationId:%v, reason:%v, err:%v", examineeSalesPaperAssociationId, reason, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// GetRescoreList 获取 before 之前停留在已提交、待人工评分或处理失败阶段，且重新算分次数少于 maxTimes 的考试
+func (uc *ExamineeSalesPaperAssociationUseCase) GetRescoreList(ctx context.Context, before time.Time, maxTimes int32, afterId string, limit int) (list []*entity.ExamineeSalesPaperAssociation, err error) {
+	l := uc.log.WithContext(ctx)
+	list, err = uc.repo.GetRescoreList(ctx, before, maxTimes, afterId, limit)
+	if err != nil {
+		l.Errorf("GetRescoreList.repo.GetRescoreList Failed, before:%v, afterId:%v, err:%v", before, afterId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+func (uc *ExamineeSalesPaperAssociationUseCase) IncrRescoreTimes(ctx context.Context, examineeSalesPaperAssociationId string) (err error) {
+	l := uc.log.WithContext(ctx)
+	err = uc.repo.IncrRescoreTimes(ctx, examineeSalesPaperAssociationId)
+	if err != nil {
+		l.Errorf("IncrRescoreTimes.repo.IncrRescoreTimes Failed, examineeSalesPaperAssociationId:%v, err:%v", examineeSalesPaperAssociationId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
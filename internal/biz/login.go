@@ -2,71 +2,446 @@ package biz
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	v1 "exam_api/api/exam_api/v1"
 	_const "exam_api/internal/const"
 	"exam_api/internal/data/entity"
 	"exam_api/internal/middleware"
+	"exam_api/internal/pkg/iclient"
+	"exam_api/internal/pkg/icontext"
+	"exam_api/internal/pkg/iemail"
+	innErr "exam_api/internal/pkg/ierrors"
 	"exam_api/internal/pkg/isecurity"
 	"exam_api/internal/pkg/isnowflake"
+	"exam_api/internal/pkg/itask"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"math/big"
+	"strconv"
+	"time"
 )
 
 type SysLoginRepo interface {
 	Create(ctx context.Context, entity *entity.SysLoginRecord) error
 }
 
+type EmailRepo interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+const (
+	loginFailWindow       = 15 * time.Minute // 失败次数统计窗口，同时也是锁定时长
+	loginFreeFails        = 3                // 免等待的失败次数
+	loginMaxDelay         = 5 * time.Second  // 单次登录最长等待时间
+	loginAccountLockFails = 10               // 账号锁定阈值
+	loginIPLockFails      = 50               // IP锁定阈值
+	loginCodeExpire       = 10 * time.Minute // 登录验证码有效期
+	loginCodeCooldown     = time.Minute      // 登录验证码发送间隔
+)
+
+// dummyPasswordHash 账号不存在时用于校验的哈希，使响应时间与密码错误一致
+const dummyPasswordHash = "$2a$12$81GDUY95n6Hmz0M0ONoT6.rjcMEyb1QGwwxk1GCZdk.aDlitkgBYe"
+
 type LoginUseCase struct {
-	repo     ExamineeRepo
-	sysLogin SysLoginRepo
-	log      *log.Helper
+	repo      ExamineeRepo
+	sysLogin  SysLoginRepo
+	email     EmailRepo
+	redisRepo RedisRepository
+	log       *log.Helper
 }
 
-func NewLoginUseCase(repo ExamineeRepo, sysLogin SysLoginRepo, logger log.Logger) *LoginUseCase {
-	return &LoginUseCase{repo: repo, sysLogin: sysLogin, log: log.NewHelper(logger)}
+func NewLoginUseCase(repo ExamineeRepo, sysLogin SysLoginRepo, email EmailRepo, redisRepo RedisRepository, logger log.Logger) *LoginUseCase {
+	return &LoginUseCase{repo: repo, sysLogin: sysLogin, email: email, redisRepo: redisRepo, log: log.NewHelper(logger)}
 }
 
 func (uc *LoginUseCase) ExamLogin(ctx context.Context, req *v1.ExamLoginRequest) (resp *v1.ExamLoginResponse, err error) {
 	resp = &v1.ExamLoginResponse{}
 	l := uc.log.WithContext(ctx)
+	client, _ := icontext.UserClientFrom(ctx)
+	// 不允许空密码登录，免密登录走验证码
+	if req.PassWord == "" {
+		err = errors.New("请输入密码")
+		return
+	}
+	// 1. 账号或IP失败次数过多时暂时锁定，失败次数越多等待越久
+	err = uc.checkLoginAttempts(ctx, req.LoginAccount, client.IP)
+	if err != nil {
+		return
+	}
 	user, err := uc.repo.GetByEmail(ctx, req.LoginAccount)
 	if err != nil {
 		l.Errorf("Login.repo.GetByEmail Failed, req:%v, err:%v", req, err.Error())
 		return nil, err
 	}
+	// 2. 账号不存在和密码错误返回相同的错误，账号不存在时同样做一次密码校验，避免通过响应时间区分
 	if user == nil {
-		return resp, errors.New("用户不存在")
+		isecurity.CheckPassword(req.PassWord, dummyPasswordHash)
+		err = uc.loginFailed(ctx, req.LoginAccount, "", client, "用户不存在")
+		return
 	}
 	// 验证密码
-	if req.PassWord != "" {
-		ok := isecurity.CheckPassword(req.PassWord, user.HashPassword)
-		if !ok {
-			err = errors.New("密码错误")
-			return
-		}
+	if !isecurity.CheckPassword(req.PassWord, user.HashPassword) {
+		err = uc.loginFailed(ctx, req.LoginAccount, user.ID, client, "密码错误")
+		return
 	}
 	if user.Status != int32(v1.ExamineeStatus_ExamineeActive) {
 		err = errors.New("用户未激活")
 		return
 	}
+	// 3. 登录成功清空账号失败次数
+	if e := uc.redisRepo.Del(ctx, loginFailAccountKey(req.LoginAccount)); e != nil {
+		l.Errorf("Login.redisRepo.Del Failed, req:%v, err:%v", req, e.Error())
+	}
+	uc.createLoginRecord(ctx, req.LoginAccount, user.ID, client, "")
+	accessJWT, refreshJWT, err := uc.issueTokens(ctx, user)
+	if err != nil {
+		return
+	}
+	resp.UserName = user.UserName
+	resp.Token = accessJWT
+	resp.RefreshToken = refreshJWT
+	return resp, nil
+}
+
+// RequestLoginCode 向考生邮箱发送一次性登录验证码。账号不存在或未激活时同样返回成功，不暴露账号是否存在
+func (uc *LoginUseCase) RequestLoginCode(ctx context.Context, req *v1.RequestLoginCodeRequest) (resp *v1.RequestLoginCodeResponse, err error) {
+	resp = &v1.RequestLoginCodeResponse{}
+	l := uc.log.WithContext(ctx)
+	// 1. 限制发送频率
+	ok, err := uc.redisRepo.SetNX(ctx, fmt.Sprintf(_const.RedisLoginCodeCooldownKey, req.LoginAccount), "", loginCodeCooldown)
+	if err != nil {
+		l.Errorf("RequestLoginCode.redisRepo.SetNX Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if !ok {
+		err = errors.New("验证码发送过于频繁，请稍后再试")
+		return
+	}
+	user, err := uc.repo.GetByEmail(ctx, req.LoginAccount)
+	if err != nil {
+		l.Errorf("RequestLoginCode.repo.GetByEmail Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if user == nil || user.Status != int32(v1.ExamineeStatus_ExamineeActive) {
+		return
+	}
+	// 2. 生成验证码，只保存哈希
+	code, err := generateLoginCode()
+	if err != nil {
+		l.Errorf("RequestLoginCode.generateLoginCode Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	err = uc.redisRepo.Set(ctx, fmt.Sprintf(_const.RedisLoginCodeKey, req.LoginAccount), hashLoginCode(req.LoginAccount, code), loginCodeExpire)
+	if err != nil {
+		l.Errorf("RequestLoginCode.redisRepo.Set Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	// 3. 异步发送邮件，响应时间不因账号是否存在而不同
+	body, err := iemail.RenderLoginCodeEmail(iemail.LoginCodeData{
+		Name:    user.UserName,
+		Code:    code,
+		Minutes: int(loginCodeExpire / time.Minute),
+	})
+	if err != nil {
+		l.Errorf("RequestLoginCode.iemail.RenderLoginCodeEmail Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	sendCtx := icontext.Detach(ctx)
+	go itask.TaskWithContext(sendCtx, func() {
+		if e := uc.email.Send(sendCtx, user.Email, "登录验证码", body); e != nil {
+			l.Errorf("RequestLoginCode.email.Send Failed, userId:%v, err:%v", user.ID, e.Error())
+		}
+	}, l)
+	return
+}
+
+// VerifyLoginCode 使用一次性验证码登录，验证码错误与密码错误一样计入失败次数
+func (uc *LoginUseCase) VerifyLoginCode(ctx context.Context, req *v1.VerifyLoginCodeRequest) (resp *v1.VerifyLoginCodeResponse, err error) {
+	resp = &v1.VerifyLoginCodeResponse{}
+	l := uc.log.WithContext(ctx)
+	client, _ := icontext.UserClientFrom(ctx)
+	err = uc.checkLoginAttempts(ctx, req.LoginAccount, client.IP)
+	if err != nil {
+		return
+	}
+	codeKey := fmt.Sprintf(_const.RedisLoginCodeKey, req.LoginAccount)
+	codeHash, err := uc.redisRepo.Get(ctx, codeKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		l.Errorf("VerifyLoginCode.redisRepo.Get Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if req.Code == "" || codeHash == "" ||
+		subtle.ConstantTimeCompare([]byte(codeHash), []byte(hashLoginCode(req.LoginAccount, req.Code))) != 1 {
+		err = uc.loginFailed(ctx, req.LoginAccount, "", client, "验证码错误")
+		return
+	}
+	// 验证码只能使用一次
+	if err = uc.redisRepo.Del(ctx, codeKey, loginFailAccountKey(req.LoginAccount)); err != nil {
+		l.Errorf("VerifyLoginCode.redisRepo.Del Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	user, err := uc.repo.GetByEmail(ctx, req.LoginAccount)
+	if err != nil {
+		l.Errorf("VerifyLoginCode.repo.GetByEmail Failed, req:%v, err:%v", req, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if user == nil || user.Status != int32(v1.ExamineeStatus_ExamineeActive) {
+		err = innErr.ErrLoginFailed
+		return
+	}
+	uc.createLoginRecord(ctx, req.LoginAccount, user.ID, client, "")
+	accessJWT, refreshJWT, err := uc.issueTokens(ctx, user)
+	if err != nil {
+		return
+	}
+	resp.UserName = user.UserName
+	resp.Token = accessJWT
+	resp.RefreshToken = refreshJWT
+	return
+}
+
+// issueTokens 登录成功后签发主令牌和刷新令牌，刷新令牌开启新的令牌族
+func (uc *LoginUseCase) issueTokens(ctx context.Context, user *entity.Examinee) (accessJWT, refreshJWT string, err error) {
+	l := uc.log.WithContext(ctx)
+	// 生成jwt
+	accessJWT, err = middleware.JWT.GenerateAccessToken(user.ID, user.UserName, "")
+	if err != nil {
+		// 处理错误
+		err = errors.New("登录失败")
+		return
+	}
+	// 生成刷新令牌，开启新的令牌族
+	refreshJWT, refreshClaims, err := middleware.JWT.GenerateRefreshToken(user.ID, "")
+	if err != nil {
+		err = errors.New("登录失败")
+		return
+	}
+	refreshKey := fmt.Sprintf(_const.RedisRefreshTokenKey, refreshClaims.Family)
+	err = uc.redisRepo.Set(ctx, refreshKey, refreshClaims.Jti, time.Until(time.Unix(refreshClaims.Exp, 0)))
+	if err != nil {
+		l.Errorf("issueTokens.redisRepo.Set Failed, userId:%v, err:%v", user.ID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// generateLoginCode 生成6位数字验证码
+func generateLoginCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashLoginCode 验证码加上账号做哈希后保存
+func hashLoginCode(loginAccount, code string) string {
+	sum := sha256.Sum256([]byte(loginAccount + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// loginFailAccountKey 账号登录失败次数 key，检查、累加和登录成功后清除都使用同一个 key
+func loginFailAccountKey(loginAccount string) string {
+	return fmt.Sprintf(_const.RedisLoginFailAccountKey, loginAccount)
+}
+
+// checkLoginAttempts 检查账号和IP的登录失败次数：超过阈值拒绝登录，超过免等待次数后按失败次数递增延迟
+func (uc *LoginUseCase) checkLoginAttempts(ctx context.Context, loginAccount, ip string) (err error) {
+	l := uc.log.WithContext(ctx)
+	accountFails, err := uc.getLoginFails(ctx, loginFailAccountKey(loginAccount))
+	if err != nil {
+		l.Errorf("checkLoginAttempts.getLoginFails Failed, loginAccount:%v, err:%v", loginAccount, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	var ipFails int64
+	if ip != "" {
+		ipFails, err = uc.getLoginFails(ctx, fmt.Sprintf(_const.RedisLoginFailIPKey, ip))
+		if err != nil {
+			l.Errorf("checkLoginAttempts.getLoginFails Failed, ip:%v, err:%v", ip, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+	}
+	if accountFails >= loginAccountLockFails || ipFails >= loginIPLockFails {
+		err = innErr.ErrLoginLocked
+		return
+	}
+	if accountFails < loginFreeFails {
+		return
+	}
+	delay := time.Duration(accountFails-loginFreeFails+1) * time.Second
+	if delay > loginMaxDelay {
+		delay = loginMaxDelay
+	}
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-time.After(delay):
+	}
+	return
+}
+
+func (uc *LoginUseCase) getLoginFails(ctx context.Context, key string) (int64, error) {
+	value, err := uc.redisRepo.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// loginFailed 累加账号和IP的失败次数并记录失败日志，统一返回账号或密码错误
+func (uc *LoginUseCase) loginFailed(ctx context.Context, loginAccount, userId string, client *iclient.ClientInfo, reason string) error {
+	l := uc.log.WithContext(ctx)
+	keys := []string{loginFailAccountKey(loginAccount)}
+	if client.IP != "" {
+		keys = append(keys, fmt.Sprintf(_const.RedisLoginFailIPKey, client.IP))
+	}
+	for _, key := range keys {
+		count, err := uc.redisRepo.Incr(ctx, key)
+		if err != nil {
+			l.Errorf("loginFailed.redisRepo.Incr Failed, key:%v, err:%v", key, err.Error())
+			continue
+		}
+		// 第一次失败时开始计时，锁定时长即计数窗口
+		if count == 1 {
+			if err = uc.redisRepo.Expire(ctx, key, loginFailWindow); err != nil {
+				l.Errorf("loginFailed.redisRepo.Expire Failed, key:%v, err:%v", key, err.Error())
+			}
+		}
+	}
+	uc.createLoginRecord(ctx, loginAccount, userId, client, reason)
+	return innErr.ErrLoginFailed
+}
+
+// createLoginRecord 记录登录日志，failReason 为空表示登录成功
+func (uc *LoginUseCase) createLoginRecord(ctx context.Context, loginAccount, userId string, client *iclient.ClientInfo, failReason string) {
+	l := uc.log.WithContext(ctx)
 	id, err := isnowflake.SnowFlake.NextID(_const.SysLoginRecordPrefix)
 	if err != nil {
-		l.Errorf("Login.isnowflake.SnowFlake.NextID Failed, req:%v, err:%v", req, err.Error())
+		l.Errorf("createLoginRecord.isnowflake.SnowFlake.NextID Failed, loginAccount:%v, err:%v", loginAccount, err.Error())
 		return
 	}
-	uc.sysLogin.Create(ctx, &entity.SysLoginRecord{
+	err = uc.sysLogin.Create(ctx, &entity.SysLoginRecord{
 		ID:            id,
-		UserID:        user.ID,
+		UserID:        userId,
 		LoginPlatform: int32(v1.LoginPlatform_Exam),
+		LoginAccount:  loginAccount,
+		IsSuccess:     failReason == "",
+		FailReason:    failReason,
+		IP:            client.IP,
+		UserAgent:     client.UserAgent,
 	})
-	// 生成jwt
+	if err != nil {
+		l.Errorf("createLoginRecord.sysLogin.Create Failed, loginAccount:%v, err:%v", loginAccount, err.Error())
+	}
+}
+
+// RefreshToken 用刷新令牌换取新的主令牌，同时轮换刷新令牌。
+// 已被轮换掉的刷新令牌再次使用时视为泄露，吊销整个令牌族，需要重新登录
+func (uc *LoginUseCase) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (resp *v1.RefreshTokenResponse, err error) {
+	resp = &v1.RefreshTokenResponse{}
+	l := uc.log.WithContext(ctx)
+	claims, err := middleware.JWT.VerifyRefreshToken(req.RefreshToken)
+	if err != nil {
+		err = innErr.ErrRefreshTokenExpired
+		return
+	}
+	user, err := uc.repo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		l.Errorf("RefreshToken.repo.GetByID Failed, userId:%v, err:%v", claims.UserID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if user == nil || user.Status != int32(v1.ExamineeStatus_ExamineeActive) {
+		err = innErr.ErrRefreshTokenExpired
+		return
+	}
+	refreshJWT, refreshClaims, err := middleware.JWT.GenerateRefreshToken(user.ID, claims.Family)
+	if err != nil {
+		l.Errorf("RefreshToken.JWT.GenerateRefreshToken Failed, userId:%v, err:%v", user.ID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	refreshKey := fmt.Sprintf(_const.RedisRefreshTokenKey, claims.Family)
+	ttl := int64(time.Until(time.Unix(refreshClaims.Exp, 0)).Seconds())
+	result, err := uc.redisRepo.Eval(ctx, _const.RotateRefreshTokenScript, []string{refreshKey}, claims.Jti, refreshClaims.Jti, ttl)
+	if err != nil {
+		l.Errorf("RefreshToken.redisRepo.Eval Failed, userId:%v, err:%v", user.ID, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	if n, ok := result.(int64); !ok || n == 0 {
+		l.Warnf("RefreshToken reuse detected, userId:%v, family:%v", user.ID, claims.Family)
+		err = innErr.ErrRefreshTokenExpired
+		return
+	}
 	accessJWT, err := middleware.JWT.GenerateAccessToken(user.ID, user.UserName, "")
 	if err != nil {
-		// 处理错误
-		err = errors.New("登录失败")
+		l.Errorf("RefreshToken.JWT.GenerateAccessToken Failed, userId:%v, err:%v", user.ID, err.Error())
+		err = innErr.ErrInternalServer
 		return
 	}
-	resp.UserName = user.UserName
 	resp.Token = accessJWT
-	return resp, nil
+	resp.RefreshToken = refreshJWT
+	return
+}
+
+// Logout 退出登录：吊销当前主令牌，传入刷新令牌时一并吊销其令牌族
+func (uc *LoginUseCase) Logout(ctx context.Context, req *v1.LogoutRequest) (resp *v1.LogoutResponse, err error) {
+	resp = &v1.LogoutResponse{}
+	l := uc.log.WithContext(ctx)
+	userId, _ := icontext.UserIdFrom(ctx)
+	accessToken, _ := icontext.UserTokenFrom(ctx)
+	claims, err := middleware.JWT.VerifyAccessToken(accessToken)
+	if err != nil {
+		err = innErr.ErrLogin
+		return
+	}
+	if claims.Jti != "" {
+		revokedKey := fmt.Sprintf(_const.RedisRevokedTokenKey, claims.Jti)
+		err = uc.redisRepo.Set(ctx, revokedKey, userId, time.Until(time.Unix(claims.Exp, 0)))
+		if err != nil {
+			l.Errorf("Logout.redisRepo.Set Failed, userId:%v, err:%v", userId, err.Error())
+			err = innErr.ErrInternalServer
+			return
+		}
+	}
+	if req.RefreshToken == "" {
+		return
+	}
+	refreshClaims, e := middleware.JWT.VerifyRefreshToken(req.RefreshToken)
+	if e != nil || refreshClaims.UserID != userId {
+		// 刷新令牌已失效或不属于当前用户，无需处理
+		return
+	}
+	err = uc.redisRepo.Del(ctx, fmt.Sprintf(_const.RedisRefreshTokenKey, refreshClaims.Family))
+	if err != nil {
+		l.Errorf("Logout.redisRepo.Del Failed, userId:%v, err:%v", userId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// IsTokenRevoked 主令牌是否已被吊销
+func (uc *LoginUseCase) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return uc.redisRepo.Exists(ctx, fmt.Sprintf(_const.RedisRevokedTokenKey, jti))
 }
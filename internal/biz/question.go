@@ -22,6 +22,13 @@ type QuestionRepo interface {
 	GetOptionList(ctx context.Context, questionId string) (res []*entity.QuestionOption, err error)
 	GetOptionListByQuestionIds(ctx context.Context, questionIds []string) (res map[string][]*entity.QuestionOption, err error)
 	GetById(ctx context.Context, questionId string) (qEntity *entity.Question, qOptionsEntities []*entity.QuestionOption, err error)
+	GetByIds(ctx context.Context, questionIds []string) (list []*entity.Question, err error)
+	GetAttachmentListByQuestionIds(ctx context.Context, questionIds []string) (res map[string][]*entity.QuestionAttachment, err error)
+}
+
+// StorageRepo 对象存储，生成附件的签名访问地址
+type StorageRepo interface {
+	SignURL(ctx context.Context, key string) (signedURL string, expiresAt time.Time, err error)
 }
 
 type QuestionUseCase struct {
@@ -66,6 +73,12 @@ func (uc *QuestionUseCase) ExamQuestion(ctx context.Context, salesPaperId string
 		err = innErr.ErrInternalServer
 		return
 	}
+	mQuestionAttachments, err := uc.repo.GetAttachmentListByQuestionIds(ctx, questionIds)
+	if err != nil {
+		l.Errorf("GetQuestionBySalesPaperId.repo.GetAttachmentListByQuestionIds Failed, questionIds:%v, err:%v", questionIds, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
 	for _, re := range res {
 		cur := &v1.QuestionData{
 			QuestionId:     re.ID,
@@ -73,12 +86,23 @@ func (uc *QuestionUseCase) ExamQuestion(ctx context.Context, salesPaperId string
 			QuestionTypeId: v1.QuestionType(re.QuestionTypeID),
 			Order:          re.Order_,
 		}
+		// 附件按题干和选项分组，缓存中 url 存放的是存储key，返回给考生前再签名
+		mOptionAttachments := make(map[string][]*v1.Attachment)
+		for _, attachment := range mQuestionAttachments[cur.QuestionId] {
+			item := &v1.Attachment{MediaType: v1.MediaType(attachment.MediaType), Url: attachment.StorageKey}
+			if attachment.QuestionOptionID == "" {
+				cur.Attachments = append(cur.Attachments, item)
+			} else {
+				mOptionAttachments[attachment.QuestionOptionID] = append(mOptionAttachments[attachment.QuestionOptionID], item)
+			}
+		}
 		if v, ok := mQuestionOptions[cur.QuestionId]; ok {
 			for _, option := range v {
 				cur.QuestionOptionsData = append(cur.QuestionOptionsData, &v1.QuestionOptionData{
 					QuestionOptionId: option.ID,
 					Description:      option.Description,
 					SerialNumber:     iutils.OrderToLetter(option.Order_),
+					Attachments:      mOptionAttachments[option.ID],
 				})
 			}
 		}
@@ -91,3 +115,117 @@ func (uc *QuestionUseCase) ExamQuestion(ctx context.Context, salesPaperId string
 	}
 	return
 }
+
+// GetQuestionsWithOptions 获取试卷全部题目及其选项（选项按题目id分组）
+func (uc *QuestionUseCase) GetQuestionsWithOptions(ctx context.Context, salesPaperId string) (questions []*entity.Question, mQuestionOptions map[string][]*entity.QuestionOption, err error) {
+	l := uc.log.WithContext(ctx)
+	questions, err = uc.repo.GetListBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("GetQuestionsWithOptions.repo.GetListBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	questionIds := make([]string, 0, len(questions))
+	for _, question := range questions {
+		questionIds = append(questionIds, question.ID)
+	}
+	mQuestionOptions, err = uc.repo.GetOptionListByQuestionIds(ctx, questionIds)
+	if err != nil {
+		l.Errorf("GetQuestionsWithOptions.repo.GetOptionListByQuestionIds Failed, questionIds:%v, err:%v", questionIds, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// DrawQuestions 按抽题规则从维度题库中为一次作答抽题，规则可按难度标签分层；同一题目不会被重复抽取，
+// 题库不足时抽取全部。返回按题目顺序排列的题目id
+func (uc *QuestionUseCase) DrawQuestions(ctx context.Context, salesPaperId string, rules []*entity.SalesPaperDrawRule, examineeAnswerId string) (questionIds []string, err error) {
+	l := uc.log.WithContext(ctx)
+	questions, err := uc.repo.GetListBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("DrawQuestions.repo.GetListBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	drawn := make(map[string]struct{})
+	for _, rule := range rules {
+		pool := make([]*entity.Question, 0)
+		for _, question := range questions {
+			if question.DimensionID != rule.DimensionID {
+				continue
+			}
+			if rule.Difficulty != "" && question.Difficulty != rule.Difficulty {
+				continue
+			}
+			if _, ok := drawn[question.ID]; ok {
+				continue
+			}
+			pool = append(pool, question)
+		}
+		shuffleRand(examineeAnswerId, "draw:"+rule.ID).Shuffle(len(pool), func(i, j int) {
+			pool[i], pool[j] = pool[j], pool[i]
+		})
+		for _, question := range pool[:min(int(rule.DrawCount), len(pool))] {
+			drawn[question.ID] = struct{}{}
+		}
+	}
+	questionIds = make([]string, 0, len(drawn))
+	for _, question := range questions {
+		if _, ok := drawn[question.ID]; ok {
+			questionIds = append(questionIds, question.ID)
+		}
+	}
+	return
+}
+
+// GetQuestionMap 批量获取题目，返回 题目id -> 题目
+func (uc *QuestionUseCase) GetQuestionMap(ctx context.Context, questionIds []string) (mQuestion map[string]*entity.Question, err error) {
+	l := uc.log.WithContext(ctx)
+	mQuestion = make(map[string]*entity.Question, len(questionIds))
+	if len(questionIds) == 0 {
+		return
+	}
+	list, err := uc.repo.GetByIds(ctx, questionIds)
+	if err != nil {
+		l.Errorf("GetQuestionMap.repo.GetByIds Failed, questionIds:%v, err:%v", questionIds, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	for _, question := range list {
+		mQuestion[question.ID] = question
+	}
+	return
+}
+
+// GetQuestionDimensionMap 获取试卷题目所属维度，返回 题目id -> 维度id
+func (uc *QuestionUseCase) GetQuestionDimensionMap(ctx context.Context, salesPaperId string) (mQuestionDimension map[string]string, err error) {
+	l := uc.log.WithContext(ctx)
+	// 先查询缓存
+	key := fmt.Sprintf(_const.GetQuestionDimensionsRedisKey, salesPaperId)
+	data, e := uc.redisRepo.Get(ctx, key)
+	if e != nil && !errors.Is(e, redis.Nil) {
+		l.Errorf("GetQuestionDimensionMap.redisRepo.Get Failed, key:%v, err:%v", key, e.Error())
+	}
+	if data != "" {
+		if e = json.Unmarshal([]byte(data), &mQuestionDimension); e == nil {
+			return
+		}
+	}
+	// 缓存没有则查询数据库
+	questions, err := uc.repo.GetListBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("GetQuestionDimensionMap.repo.GetListBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	mQuestionDimension = make(map[string]string, len(questions))
+	for _, question := range questions {
+		mQuestionDimension[question.ID] = question.DimensionID
+	}
+	value, _ := json.Marshal(mQuestionDimension)
+	if e = uc.redisRepo.Set(ctx, key, string(value), time.Duration(3)*time.Minute); e != nil {
+		l.Errorf("GetQuestionDimensionMap.redisRepo.Set Failed, key:%v, err:%v", key, e.Error())
+	}
+	return
+}
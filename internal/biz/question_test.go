@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"exam_api/internal/data/entity"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeQuestionRepo 只实现抽题用到的题目查询
+type fakeQuestionRepo struct {
+	QuestionRepo
+	questions []*entity.Question
+}
+
+func (r *fakeQuestionRepo) GetListBySalesPaperId(ctx context.Context, salesPaperId string) ([]*entity.Question, error) {
+	return r.questions, nil
+}
+
+func TestDrawQuestions(t *testing.T) {
+	questions := make([]*entity.Question, 0)
+	for i := 1; i <= 10; i++ {
+		difficulty := "easy"
+		if i > 6 {
+			difficulty = "hard"
+		}
+		questions = append(questions, &entity.Question{ID: fmt.Sprintf("Q%02d", i), DimensionID: "D1", Difficulty: difficulty})
+	}
+	questions = append(questions, &entity.Question{ID: "Q11", DimensionID: "D2"}, &entity.Question{ID: "Q12", DimensionID: "D2"})
+	mQuestion := make(map[string]*entity.Question, len(questions))
+	for _, question := range questions {
+		mQuestion[question.ID] = question
+	}
+	uc := NewQuestionUseCase(&fakeQuestionRepo{questions: questions}, nil, log.DefaultLogger)
+	cases := []struct {
+		name  string
+		rules []*entity.SalesPaperDrawRule
+		want  map[string]int // 维度|难度 -> 抽题数
+	}{
+		{
+			"按维度抽题",
+			[]*entity.SalesPaperDrawRule{{ID: "R1", DimensionID: "D1", DrawCount: 3}, {ID: "R2", DimensionID: "D2", DrawCount: 1}},
+			map[string]int{"D1": 3, "D2": 1},
+		},
+		{
+			"按难度分层",
+			[]*entity.SalesPaperDrawRule{{ID: "R1", DimensionID: "D1", Difficulty: "easy", DrawCount: 2}, {ID: "R2", DimensionID: "D1", Difficulty: "hard", DrawCount: 2}},
+			map[string]int{"D1|easy": 2, "D1|hard": 2},
+		},
+		{
+			"题库不足时抽取全部",
+			[]*entity.SalesPaperDrawRule{{ID: "R1", DimensionID: "D2", DrawCount: 5}},
+			map[string]int{"D2": 2},
+		},
+		{
+			"同一题目不重复抽取",
+			[]*entity.SalesPaperDrawRule{{ID: "R1", DimensionID: "D1", Difficulty: "hard", DrawCount: 4}, {ID: "R2", DimensionID: "D1", DrawCount: 10}},
+			map[string]int{"D1": 10},
+		},
+	}
+	for _, c := range cases {
+		got, err := uc.DrawQuestions(context.Background(), "SP1", c.rules, "EA1")
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		again, _ := uc.DrawQuestions(context.Background(), "SP1", c.rules, "EA1")
+		if !slices.Equal(got, again) {
+			t.Errorf("%s: 同一作答两次抽题不同: %v, %v", c.name, got, again)
+		}
+		if !slices.IsSorted(got) {
+			t.Errorf("%s: 未按题目顺序排列: %v", c.name, got)
+		}
+		if len(slices.Compact(slices.Clone(got))) != len(got) {
+			t.Errorf("%s: 重复抽题: %v", c.name, got)
+		}
+		counts := make(map[string]int)
+		for _, id := range got {
+			question := mQuestion[id]
+			counts[question.DimensionID]++
+			counts[question.DimensionID+"|"+question.Difficulty]++
+		}
+		for key, want := range c.want {
+			if counts[key] != want {
+				t.Errorf("%s: %s drew %d, want %d (%v)", c.name, key, counts[key], want, got)
+			}
+		}
+	}
+	// 不同作答抽到的题目不同
+	rules := []*entity.SalesPaperDrawRule{{ID: "R1", DimensionID: "D1", DrawCount: 3}}
+	a, _ := uc.DrawQuestions(context.Background(), "SP1", rules, "EA1")
+	b, _ := uc.DrawQuestions(context.Background(), "SP1", rules, "EA2")
+	c, _ := uc.DrawQuestions(context.Background(), "SP1", rules, "EA3")
+	if slices.Equal(a, b) && slices.Equal(b, c) {
+		t.Errorf("different attempts drew the same questions: %v", a)
+	}
+}
@@ -10,6 +10,12 @@ import (
 
 type SalesPaperRepo interface {
 	GetByID(ctx context.Context, salesPaperId string) (resEntity *entity.SalesPaper, err error)
+	GetByIDs(ctx context.Context, salesPaperIds []string) (list []*entity.SalesPaper, err error)
+	GetDimensionListBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperDimension, err error)
+	GetDrawRulesBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperDrawRule, err error)
+	GetSectionListBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperSection, err error)
+	GetCommentListBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperComment, err error)
+	GetDimensionCommentListByDimensionIds(ctx context.Context, dimensionIds []string) (list []*entity.SalesPaperDimensionComment, err error)
 }
 
 type SalesPaperUseCase struct {
@@ -36,6 +42,91 @@ func (uc *SalesPaperUseCase) GetSalesPaperDetail(ctx context.Context, salesPaper
 	return
 }
 
+// GetSalesPaperMap 批量获取试卷，返回 试卷id -> 试卷
+func (uc *SalesPaperUseCase) GetSalesPaperMap(ctx context.Context, salesPaperIds []string) (mSalesPaper map[string]*entity.SalesPaper, err error) {
+	l := uc.log.WithContext(ctx)
+	mSalesPaper = make(map[string]*entity.SalesPaper, len(salesPaperIds))
+	if len(salesPaperIds) == 0 {
+		return
+	}
+	list, err := uc.repo.GetByIDs(ctx, salesPaperIds)
+	if err != nil {
+		l.Errorf("GetSalesPaperMap.repo.GetByIDs Failed, salesPaperIds:%v, err:%v", salesPaperIds, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	for _, salesPaper := range list {
+		mSalesPaper[salesPaper.ID] = salesPaper
+	}
+	return
+}
+
+func (uc *SalesPaperUseCase) GetDimensionList(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperDimension, err error) {
+	l := uc.log.WithContext(ctx)
+	list, err = uc.repo.GetDimensionListBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("GetDimensionList.repo.GetDimensionListBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// GetDrawRules 获取试卷的抽题规则，没有规则表示作答全部题目
+func (uc *SalesPaperUseCase) GetDrawRules(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperDrawRule, err error) {
+	l := uc.log.WithContext(ctx)
+	list, err = uc.repo.GetDrawRulesBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("GetDrawRules.repo.GetDrawRulesBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// GetSectionList 获取试卷的分部（按顺序），没有分部表示整卷作答
+func (uc *SalesPaperUseCase) GetSectionList(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperSection, err error) {
+	l := uc.log.WithContext(ctx)
+	list, err = uc.repo.GetSectionListBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("GetSectionList.repo.GetSectionListBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// GetCommentList 获取试卷总分的区间评语
+func (uc *SalesPaperUseCase) GetCommentList(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperComment, err error) {
+	l := uc.log.WithContext(ctx)
+	list, err = uc.repo.GetCommentListBySalesPaperId(ctx, salesPaperId)
+	if err != nil {
+		l.Errorf("GetCommentList.repo.GetCommentListBySalesPaperId Failed, salesPaperId:%v, err:%v", salesPaperId, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	return
+}
+
+// GetDimensionCommentMap 批量获取维度的区间评语，返回 维度id -> 评语列表
+func (uc *SalesPaperUseCase) GetDimensionCommentMap(ctx context.Context, dimensionIds []string) (mComment map[string][]*entity.SalesPaperDimensionComment, err error) {
+	l := uc.log.WithContext(ctx)
+	mComment = make(map[string][]*entity.SalesPaperDimensionComment, len(dimensionIds))
+	if len(dimensionIds) == 0 {
+		return
+	}
+	list, err := uc.repo.GetDimensionCommentListByDimensionIds(ctx, dimensionIds)
+	if err != nil {
+		l.Errorf("GetDimensionCommentMap.repo.GetDimensionCommentListByDimensionIds Failed, dimensionIds:%v, err:%v", dimensionIds, err.Error())
+		err = innErr.ErrInternalServer
+		return
+	}
+	for _, comment := range list {
+		mComment[comment.SalesPaperDimensionID] = append(mComment[comment.SalesPaperDimensionID], comment)
+	}
+	return
+}
+
 func (uc *SalesPaperUseCase) CheckSalesPaper(ctx context.Context, iSalesPaperId string, l *log.Helper) (err error) {
 	salesPaper, err := uc.repo.GetByID(ctx, iSalesPaperId)
 	if err != nil {
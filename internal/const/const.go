@@ -15,6 +15,7 @@ const (
 	ExamineeAnswerPrefix                    = "EAP"
 	ExamineeAnswerDimensionScorePrefix      = "EADSP"
 	ExamineeAnswerQuestionAnswerPrefix      = "EAQAP"
+	ExamineeAnswerQuestionRevisionPrefix    = "EAQRP"
 	ExamiEventPrefix                        = "EEP"
 )
 
@@ -24,11 +25,29 @@ var AllowedVars = map[string]interface{}{
 	"standard_mark": 0.0,
 }
 
+// 员工角色，由主令牌的 role 携带；考生的主令牌 role 为空
+const (
+	RoleGrader       = "grader"       // 评分人员，可以人工评分
+	RoleRecruiter    = "recruiter"    // 招聘人员，可以查看自己安排的考试的完整结果报告和答案修改记录
+	RoleInvestigator = "investigator" // 监考复核人员，可以回放任意考试的答案修改记录
+)
+
+// 不需要校验主令牌的接口
+var SkipAccessTokenMethod = map[string]struct{}{
+	"/exam_api.v1.ExamService/ExamLogin":        struct{}{},
+	"/exam_api.v1.ExamService/RefreshToken":     struct{}{},
+	"/exam_api.v1.ExamService/RequestLoginCode": struct{}{},
+	"/exam_api.v1.ExamService/VerifyLoginCode":  struct{}{},
+}
+
 var VerifyExamTokenMethod = map[string]struct{}{
 	"/exam_api.v1.ExamService/ExamQuestion":       struct{}{},
 	"/exam_api.v1.ExamService/ExamQuestionRecord": struct{}{},
 	"/exam_api.v1.ExamService/HeartbeatAndSave":   struct{}{},
 	"/exam_api.v1.ExamService/SubmitExam":         struct{}{},
+	"/exam_api.v1.ExamService/SubmitSection":      struct{}{},
+	"/exam_api.v1.ExamService/NextQuestion":       struct{}{},
+	"/exam_api.v1.ExamService/ReportExamEvents":   struct{}{},
 }
 
 // 邮件模板
@@ -56,6 +75,16 @@ const EmailTemplate = `
 {{.SendDate}}
 `
 
+// 登录验证码邮件模板
+const LoginCodeEmailTemplate = `
+尊敬的{{.Name}}您好：
+您正在登录在线测评系统，本次登录验证码为：
+
+{{.Code}}
+
+验证码 {{.Minutes}} 分钟内有效，且只能使用一次。如非本人操作，请忽略本邮件。
+`
+
 type ExamEventType string
 
 const (
@@ -63,4 +92,22 @@ const (
 	ExamEventLongInactive ExamEventType = "long_inactive" // 长时间无心跳
 	ExamEventSubmit       ExamEventType = "submit"        // 提交
 	ExamEventTimeUp       ExamEventType = "time_up"       // 时间到
+	ExamEventExpire       ExamEventType = "expire"        // 过了截止时间
+	ExamEventFingerprint  ExamEventType = "fingerprint"   // 客户端指纹变化
+	ExamEventTakeover     ExamEventType = "takeover"      // 新会话顶替旧会话
+	// 以下由浏览器上报
+	ExamEventSwitchTab        ExamEventType = "switch_tab"        // 切换标签页
+	ExamEventCopy             ExamEventType = "copy"              // 复制
+	ExamEventPaste            ExamEventType = "paste"             // 粘贴
+	ExamEventVisibilityHidden ExamEventType = "visibility_hidden" // 页面不可见
+	ExamEventReentry          ExamEventType = "exam_reentry"      // 重新进入考试
 )
+
+// 允许浏览器上报的事件类型
+var ClientExamEventTypes = map[ExamEventType]struct{}{
+	ExamEventSwitchTab:        struct{}{},
+	ExamEventCopy:             struct{}{},
+	ExamEventPaste:            struct{}{},
+	ExamEventVisibilityHidden: struct{}{},
+	ExamEventReentry:          struct{}{},
+}
@@ -1,9 +1,21 @@
 package _const
 
 var (
-	GetQuestionsBySalesPaperIdRedisKey = "questions:%s"        // %s为试卷id
-	RedisLockKey                       = "exam_lock:submit:%s" // 分布式锁 key
-	RedisSubmitKey                     = "exam_submitted:%s"   // 已提交标记 key
+	GetQuestionsBySalesPaperIdRedisKey = "questions:%s"           // %s为试卷id
+	GetQuestionDimensionsRedisKey      = "question_dims:%s"       // 题目id -> 维度id，%s为试卷id
+	RedisLockKey                       = "exam_lock:submit:%s"    // 分布式锁 key
+	RedisSubmitKey                     = "exam_submitted:%s"      // 已提交标记 key
+	RedisSweeperLeaseKey               = "exam_lock:sweeper"      // 过期考试扫描租约 key
+	RedisRevokedTokenKey               = "token_revoked:%s"       // 已吊销的主令牌 key，%s为jti
+	RedisRefreshTokenKey               = "refresh_token:%s"       // 刷新令牌族当前有效的jti，%s为令牌族
+	RedisActiveSessionKey              = "exam_session:%s"        // 考试当前在线的会话id，空闲过期，%s为关联id
+	RedisLatestSessionKey              = "exam_session_latest:%s" // 最近一次进入考试签发的会话id和客户端指纹，%s为关联id
+	RedisLoginFailAccountKey           = "login_fail:account:%s"  // 账号登录失败次数，%s为登录账号
+	RedisLoginFailIPKey                = "login_fail:ip:%s"       // IP登录失败次数，%s为IP
+	RedisLoginCodeKey                  = "login_code:%s"          // 登录验证码哈希，%s为登录账号
+	RedisLoginCodeCooldownKey          = "login_code_wait:%s"     // 登录验证码发送间隔，%s为登录账号
+	RedisFingerprintEventKey           = "exam_fp_event:%s:%s"    // 已记录的指纹变化，%s为会话id和本次指纹
+	RedisExamEventRateKey              = "exam_event_rate:%s"     // 会话上报事件次数，%s为会话id
 	UnlockScript                       = `
 		if redis.call("get", KEYS[1]) == ARGV[1] then
 			return redis.call("del", KEYS[1])
@@ -11,4 +23,23 @@ var (
 			return 0
 		end
 		`
+	// RotateRefreshTokenScript 刷新令牌轮换：当前有效jti与请求一致时替换为新jti，
+	// 否则视为旧令牌被重复使用，吊销整个令牌族
+	RotateRefreshTokenScript = `
+		if redis.call("get", KEYS[1]) == ARGV[1] then
+			redis.call("set", KEYS[1], ARGV[2], "EX", ARGV[3])
+			return 1
+		else
+			redis.call("del", KEYS[1])
+			return 0
+		end
+		`
+	// IncrWithExpireScript 计数加1，计数没有过期时间时设置过期时间（秒），计数和过期时间一起生效
+	IncrWithExpireScript = `
+		local count = redis.call("incr", KEYS[1])
+		if redis.call("ttl", KEYS[1]) < 0 then
+			redis.call("expire", KEYS[1], ARGV[1])
+		end
+		return count
+		`
 )
@@ -26,6 +26,9 @@ var ProviderSet = wire.NewSet(NewData,
 	NewExamineeAnswerRepo,
 	NewExamineeQuestionAnswerRepo,
 	NewExamEventRepo,
+	NewExamineeAnswerDimensionScoreRepo,
+	NewEmailRepo,
+	NewStorageRepo,
 	RedisRepositoryFromData)
 
 type Data struct {
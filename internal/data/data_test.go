@@ -0,0 +1,38 @@
+package data
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// newMockData 基于 sqlmock 构造 Data，用于校验仓储生成的 SQL
+func newMockData(t *testing.T) (*Data, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+	return &Data{db: db}, mock
+}
+
+// anyArgs 构造参数匹配：前 before 个任意，然后是 value，再跟 after 个任意
+func anyArgs(before int, value interface{}, after int) []driver.Value {
+	args := make([]driver.Value, 0, before+1+after)
+	for i := 0; i < before; i++ {
+		args = append(args, sqlmock.AnyArg())
+	}
+	args = append(args, value)
+	for i := 0; i < after; i++ {
+		args = append(args, sqlmock.AnyArg())
+	}
+	return args
+}
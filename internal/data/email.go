@@ -0,0 +1,35 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"exam_api/internal/biz"
+	"exam_api/internal/conf"
+	"exam_api/internal/pkg/iemail"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type EmailRepo struct {
+	config *conf.Data_Email
+	log    *log.Helper
+}
+
+func NewEmailRepo(c *conf.Data, logger log.Logger) biz.EmailRepo {
+	return &EmailRepo{
+		config: c.Email,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *EmailRepo) Send(ctx context.Context, to, subject, body string) error {
+	if r.config == nil || r.config.Host == "" {
+		return errors.New("email is not configured")
+	}
+	return iemail.SendMail(iemail.SmtpConfig{
+		Host:     r.config.Host,
+		Port:     int(r.config.Port),
+		Username: r.config.Username,
+		Password: r.config.Password,
+		From:     r.config.From,
+	}, to, subject, body)
+}
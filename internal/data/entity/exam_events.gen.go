@@ -22,6 +22,7 @@ type ExamEvent struct {
 	IP               string         `gorm:"column:ip;not null;comment:客户端 IP" json:"ip"`                                                                                                    // 客户端 IP
 	UserAgent        string         `gorm:"column:user_agent;comment:浏览器 User-Agent" json:"user_agent"`                                                                                     // 浏览器 User-Agent
 	Meta             string         `gorm:"column:meta;comment:事件附加信息，如：gap_seconds, used_duration, question_count" json:"meta"`                                                            // 事件附加信息，如：gap_seconds, used_duration, question_count
+	ClientTime       *time.Time     `gorm:"column:client_time;comment:客户端事件时间" json:"client_time"`                                                                                          // 客户端事件时间
 	CreatedAt        time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                            // 创建时间
 	UpdatedAt        time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                            // 更新时间
 	CreatedBy        string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                                                                                     // 创建人标识
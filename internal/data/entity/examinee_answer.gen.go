@@ -26,7 +26,14 @@ type ExamineeAnswer struct {
 	Comparability                   int32          `gorm:"column:comparability;not null;comment:匹配度" json:"comparability"`                                                    // 匹配度
 	Deadline                        time.Time      `gorm:"column:deadline;not null;comment:试卷截止时刻" json:"deadline"`                                                           // 试卷截止时刻
 	Usability                       int32          `gorm:"column:usability;not null;default:1;comment:试卷有效性（1~4）" json:"usability"`                                           // 试卷有效性（1~4）
+	IntegrityScore                  float64        `gorm:"column:integrity_score;not null;default:100.00;comment:作答诚信分（0~100）" json:"integrity_score"`                        // 作答诚信分（0~100）
+	IntegrityFlags                  string         `gorm:"column:integrity_flags;not null;comment:作答异常标记（JSON）" json:"integrity_flags"`                                       // 作答异常标记（JSON）
+	IsPractice                      bool           `gorm:"column:is_practice;not null;default:0;comment:是否练习作答（不计入成绩和统计）" json:"is_practice"`                                 // 是否练习作答（不计入成绩和统计）
+	QuestionIds                     string         `gorm:"column:question_ids;not null;comment:本次作答抽取的题目ID（JSON），为空表示使用试卷全部题目" json:"question_ids"`                           // 本次作答抽取的题目ID（JSON），为空表示使用试卷全部题目
+	SectionState                    string         `gorm:"column:section_state;not null;comment:分部作答状态（JSON）" json:"section_state"`                                           // 分部作答状态（JSON）
+	AdaptiveState                   string         `gorm:"column:adaptive_state;not null;comment:自适应作答状态（JSON）" json:"adaptive_state"`                                        // 自适应作答状态（JSON）
 	RemainingTimelimit              int32          `gorm:"column:remaining_timelimit;not null;comment:考试剩余时长" json:"remaining_timelimit"`                                     // 考试剩余时长
+	DueAt                           time.Time      `gorm:"column:due_at;not null;comment:剩余时间用完的时刻（最后活动时刻+剩余时长）" json:"due_at"`                                               // 剩余时间用完的时刻（最后活动时刻+剩余时长）
 	CreatedAt                       time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                               // 创建时间
 	UpdatedAt                       time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                               // 更新时间
 	CreatedBy                       string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                                                        // 创建人标识
@@ -19,6 +19,8 @@ type ExamineeAnswerDimensionScore struct {
 	DimensionID            string         `gorm:"column:dimension_id;not null;comment:Dimension表的ID" json:"dimension_id"`                              // Dimension表的ID
 	DimensionRawScore      float64        `gorm:"column:dimension_raw_score;not null;default:0.00;comment:维度原始分" json:"dimension_raw_score"`           // 维度原始分
 	DimensionStandardScore float64        `gorm:"column:dimension_standard_score;not null;default:0.00;comment:维度标准分" json:"dimension_standard_score"` // 维度标准分
+	Theta                  float64        `gorm:"column:theta;not null;default:0.00;comment:能力估计值" json:"theta"`                                       // 能力估计值
+	ThetaSe                float64        `gorm:"column:theta_se;not null;default:0.00;comment:能力估计标准误" json:"theta_se"`                               // 能力估计标准误
 	CreatedAt              time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                 // 创建时间
 	UpdatedAt              time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                 // 更新时间
 	CreatedBy              string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                                          // 创建人标识
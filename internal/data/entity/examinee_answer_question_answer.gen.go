@@ -19,6 +19,12 @@ type ExamineeAnswerQuestionAnswer struct {
 	QuestionID       string         `gorm:"column:question_id;not null;comment:Question表ID" json:"question_id"`                     // Question表ID
 	Score            float64        `gorm:"column:score;not null;default:0.00;comment:得分" json:"score"`                             // 得分
 	OptionSign       string         `gorm:"column:option_sign;comment:选项标记：例如ABCD" json:"option_sign"`                              // 选项标记：例如ABCD
+	TimeSpentMs      int64          `gorm:"column:time_spent_ms;not null;default:0;comment:累计作答用时（毫秒）" json:"time_spent_ms"`        // 累计作答用时（毫秒）
+	ChangeCount      int32          `gorm:"column:change_count;not null;default:0;comment:累计修改答案次数" json:"change_count"`            // 累计修改答案次数
+	TextAnswer       string         `gorm:"column:text_answer;type:text;comment:填空、数值、问答题答案" json:"text_answer"`                    // 填空、数值、问答题答案
+	GradeStatus      int32          `gorm:"column:grade_status;not null;default:0;comment:评分状态：0无需评分1待评分2已评分" json:"grade_status"`  // 评分状态：0无需评分1待评分2已评分
+	GradedBy         string         `gorm:"column:graded_by;not null;comment:评分人标识" json:"graded_by"`                               // 评分人标识
+	GradedAt         *time.Time     `gorm:"column:graded_at;comment:评分时间" json:"graded_at"`                                         // 评分时间
 	CreatedAt        time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`    // 创建时间
 	UpdatedAt        time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`    // 更新时间
 	CreatedBy        string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                             // 创建人标识
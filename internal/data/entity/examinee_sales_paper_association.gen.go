@@ -20,6 +20,11 @@ type ExamineeSalesPaperAssociation struct {
 	ExamineeID     string         `gorm:"column:examinee_id;not null;comment:关联考生ID" json:"examinee_id"`                              // 关联考生ID
 	EmailStatus    int32          `gorm:"column:email_status;not null;default:1;comment:邮件状态：1.未发送，2.已发送，3.发送失败" json:"email_status"` // 邮件状态：1.未发送，2.已发送，3.发送失败
 	StageNumber    int32          `gorm:"column:stage_number;not null;comment:阶段编号（0~5）" json:"stage_number"`                         // 阶段编号（0~5）
+	FailReason     string         `gorm:"column:fail_reason;not null;comment:处理失败原因" json:"fail_reason"`                              // 处理失败原因
+	RescoreTimes   int32          `gorm:"column:rescore_times;not null;default:0;comment:后台重新算分次数" json:"rescore_times"`              // 后台重新算分次数
+	OpenAt         *time.Time     `gorm:"column:open_at;comment:考试开放时刻" json:"open_at"`                                               // 考试开放时刻
+	CloseAt        *time.Time     `gorm:"column:close_at;comment:考试关闭时刻" json:"close_at"`                                             // 考试关闭时刻
+	TimeLimit      int32          `gorm:"column:time_limit;not null;comment:考试时长（分钟），0表示使用试卷建议时长" json:"time_limit"`                  // 考试时长（分钟），0表示使用试卷建议时长
 	CreatedAt      time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`        // 创建时间
 	UpdatedAt      time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`        // 更新时间
 	CreatedBy      string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                                 // 创建人标识
@@ -14,22 +14,31 @@ const TableNameSalesPaper = "sales_paper"
 
 // SalesPaper 售卷，作为系统对外销售的产品
 type SalesPaper struct {
-	ID               string         `gorm:"column:id;primaryKey;comment:主键" json:"id"`                                           // 主键
-	Name             string         `gorm:"column:name;not null;comment:售卷名称" json:"name"`                                       // 售卷名称
-	RecommendTimeLim int32          `gorm:"column:recommend_time_lim;not null;comment:推荐售卷时长" json:"recommend_time_lim"`         // 推荐售卷时长
-	MaxScore         float64        `gorm:"column:max_score;not null;default:0.00;comment:最高分数上限" json:"max_score"`              // 最高分数上限
-	MinScore         float64        `gorm:"column:min_score;not null;default:0.00;comment:最低分数下限" json:"min_score"`              // 最低分数下限
-	IsEnabled        bool           `gorm:"column:is_enabled;not null;comment:是否启用" json:"is_enabled"`                           // 是否启用
-	IsUsed           bool           `gorm:"column:is_used;not null;comment:是否已使用" json:"is_used"`                                // 是否已使用
-	Expression       string         `gorm:"column:expression;not null;comment:标准分计算公式" json:"expression"`                        // 标准分计算公式
-	Rounding         int32          `gorm:"column:rounding;not null;default:1;comment:保留小数位" json:"rounding"`                    // 保留小数位
-	IsSumScore       bool           `gorm:"column:is_sum_score;not null;comment:是否需要总分" json:"is_sum_score"`                     // 是否需要总分
-	Mark             string         `gorm:"column:mark;not null;comment:备注" json:"mark"`                                         // 备注
-	CreatedAt        time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
-	UpdatedAt        time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
-	CreatedBy        string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                          // 创建人标识
-	UpdatedBy        string         `gorm:"column:updated_by;not null;comment:更新人标识" json:"updated_by"`                          // 更新人标识
-	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;comment:逻辑删除时间" json:"deleted_at"`                                  // 逻辑删除时间
+	ID               string         `gorm:"column:id;primaryKey;comment:主键" json:"id"`                                                              // 主键
+	Name             string         `gorm:"column:name;not null;comment:售卷名称" json:"name"`                                                          // 售卷名称
+	RecommendTimeLim int32          `gorm:"column:recommend_time_lim;not null;comment:推荐售卷时长" json:"recommend_time_lim"`                            // 推荐售卷时长
+	MaxScore         float64        `gorm:"column:max_score;not null;default:0.00;comment:最高分数上限" json:"max_score"`                                 // 最高分数上限
+	MinScore         float64        `gorm:"column:min_score;not null;default:0.00;comment:最低分数下限" json:"min_score"`                                 // 最低分数下限
+	IsEnabled        bool           `gorm:"column:is_enabled;not null;comment:是否启用" json:"is_enabled"`                                              // 是否启用
+	IsUsed           bool           `gorm:"column:is_used;not null;comment:是否已使用" json:"is_used"`                                                   // 是否已使用
+	Expression       string         `gorm:"column:expression;not null;comment:标准分计算公式" json:"expression"`                                           // 标准分计算公式
+	Rounding         int32          `gorm:"column:rounding;not null;default:1;comment:保留小数位" json:"rounding"`                                       // 保留小数位
+	IsSumScore       bool           `gorm:"column:is_sum_score;not null;comment:是否需要总分" json:"is_sum_score"`                                        // 是否需要总分
+	KickOldSession   bool           `gorm:"column:kick_old_session;not null;default:1;comment:重复进入考试时是否踢掉旧会话" json:"kick_old_session"`              // 重复进入考试时是否踢掉旧会话
+	ShuffleQuestions bool           `gorm:"column:shuffle_questions;not null;default:0;comment:是否打乱题目顺序" json:"shuffle_questions"`                  // 是否打乱题目顺序
+	ShuffleOptions   bool           `gorm:"column:shuffle_options;not null;default:0;comment:是否打乱选项顺序" json:"shuffle_options"`                      // 是否打乱选项顺序
+	AllRequired      bool           `gorm:"column:all_required;not null;default:0;comment:是否全部题目作答后才能交卷" json:"all_required"`                       // 是否全部题目作答后才能交卷
+	IntegrityConfig  string         `gorm:"column:integrity_config;not null;comment:作答有效性判定阈值（JSON）" json:"integrity_config"`                       // 作答有效性判定阈值（JSON）
+	IsPractice       bool           `gorm:"column:is_practice;not null;default:0;comment:是否练习卷" json:"is_practice"`                                 // 是否练习卷
+	Adaptive         bool           `gorm:"column:adaptive;not null;default:0;comment:是否自适应出题" json:"adaptive"`                                     // 是否自适应出题
+	ResultVisibility int32          `gorm:"column:result_visibility;not null;default:0;comment:考生可见的成绩范围：0不可见，1仅分数，2完整报告" json:"result_visibility"` // 考生可见的成绩范围：0不可见，1仅分数，2完整报告
+	AdaptiveConfig   string         `gorm:"column:adaptive_config;not null;comment:自适应终止规则（JSON）" json:"adaptive_config"`                           // 自适应终止规则（JSON）
+	Mark             string         `gorm:"column:mark;not null;comment:备注" json:"mark"`                                                            // 备注
+	CreatedAt        time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                    // 创建时间
+	UpdatedAt        time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                    // 更新时间
+	CreatedBy        string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                                             // 创建人标识
+	UpdatedBy        string         `gorm:"column:updated_by;not null;comment:更新人标识" json:"updated_by"`                                             // 更新人标识
+	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;comment:逻辑删除时间" json:"deleted_at"`                                                     // 逻辑删除时间
 }
 
 // TableName SalesPaper's table name
@@ -23,6 +23,7 @@ type SalesPaperDimension struct {
 	MaxScore     float64        `gorm:"column:max_score;not null;default:0.00;comment:最高分数上限" json:"max_score"`              // 最高分数上限
 	MinScore     float64        `gorm:"column:min_score;not null;default:0.00;comment:最低分数下限" json:"min_score"`              // 最低分数下限
 	IsChoose     bool           `gorm:"column:is_choose;not null;comment:是否可选择该维度" json:"is_choose"`                         // 是否可选择该维度
+	SectionID    string         `gorm:"column:section_id;not null;comment:所属分部ID" json:"section_id"`                         // 所属分部ID
 	CreatedAt    time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
 	UpdatedAt    time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
 	CreatedBy    string         `gorm:"column:created_by;not null;comment:创建人标识" json:"created_by"`                          // 创建人标识
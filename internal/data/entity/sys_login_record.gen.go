@@ -15,6 +15,11 @@ type SysLoginRecord struct {
 	ID            string    `gorm:"column:id;primaryKey;comment:主键" json:"id"`                                                // 主键
 	UserID        string    `gorm:"column:user_id;not null;comment:记录登录者ID" json:"user_id"`                                   // 记录登录者ID
 	LoginPlatform int32     `gorm:"column:login_platform;not null;default:1;comment:登录平台: 1.企业后台 2.候选" json:"login_platform"` // 登录平台: 1.企业后台 2.候选
+	LoginAccount  string    `gorm:"column:login_account;not null;comment:登录账号" json:"login_account"`                          // 登录账号
+	IsSuccess     bool      `gorm:"column:is_success;not null;comment:是否登录成功" json:"is_success"`                              // 是否登录成功
+	FailReason    string    `gorm:"column:fail_reason;not null;comment:登录失败原因" json:"fail_reason"`                            // 登录失败原因
+	IP            string    `gorm:"column:ip;not null;comment:登录IP" json:"ip"`                                                // 登录IP
+	UserAgent     string    `gorm:"column:user_agent;not null;comment:登录客户端UA" json:"user_agent"`                             // 登录客户端UA
 	CreatedAt     time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:登录时间" json:"created_at"`      // 登录时间
 }
 
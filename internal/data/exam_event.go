@@ -22,3 +22,15 @@ func NewExamEventRepo(data *Data, logger log.Logger) biz.ExamEventRepo {
 func (r *ExamEventRepo) ExamEvent(ctx context.Context, examEvent *entity.ExamEvent) error {
 	return r.data.db.WithContext(ctx).Create(examEvent).Error
 }
+
+func (r *ExamEventRepo) BatchCreate(ctx context.Context, examEvents []*entity.ExamEvent) error {
+	return r.data.db.WithContext(ctx).CreateInBatches(examEvents, 100).Error
+}
+
+func (r *ExamEventRepo) GetByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamEvent, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.ExamEvent{}).Where(" examinee_answer_id = ? ", examineeAnswerId).Order(" created_at ").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	v1 "exam_api/api/exam_api/v1"
 	"exam_api/internal/biz"
 	"exam_api/internal/data/entity"
 	"github.com/go-kratos/kratos/v2/log"
@@ -30,6 +31,16 @@ func (r *ExamineeAnswerRepo) GetByAssociationId(ctx context.Context, association
 	return resEntity, nil
 }
 
+func (r *ExamineeAnswerRepo) GetById(ctx context.Context, id string) (resEntity *entity.ExamineeAnswer, err error) {
+	resEntity, err = getSingleRecordByScope[entity.ExamineeAnswer](
+		r.data.db.WithContext(ctx).Model(resEntity).Where(" id = ? ", id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resEntity, nil
+}
+
 func (r *ExamineeAnswerRepo) GetByIDs(ctx context.Context, examineeId string) (list []*entity.ExamineeAnswer, err error) {
 	err = r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{}).Where(" examinee_id = ? ", examineeId).Find(&list).Error
 	if err != nil {
@@ -42,14 +53,35 @@ func (r *ExamineeAnswerRepo) Create(ctx context.Context, examineeAnswer *entity.
 	return r.data.db.WithContext(ctx).Create(examineeAnswer).Error
 }
 
+// GetOverdueList 获取进行中且剩余时间已用完或已过截止时间的答题记录。
+// 剩余时间用完以 due_at 为准，考生离开后不再发送请求也会被选中。
+// 按 (last_action_time, id) 分页，after 为上一页最后一条，第一页传 nil
+func (r *ExamineeAnswerRepo) GetOverdueList(ctx context.Context, now time.Time, after *entity.ExamineeAnswer, limit int) (list []*entity.ExamineeAnswer, err error) {
+	db := r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{})
+	if after != nil {
+		db = db.Where(" examinee_answer.last_action_time > ? or (examinee_answer.last_action_time = ? and examinee_answer.id > ?) ", after.LastActionTime, after.LastActionTime, after.ID)
+	}
+	err = db.
+		Joins(" join examinee_sales_paper_association s on s.id = examinee_answer.examinee_sales_paper_association_id and s.deleted_at is null ").
+		Where(" s.stage_number = ? or (examinee_answer.is_practice = 1 and examinee_answer.submit_time is null) ", v1.StageNumber_InProgress).
+		Where(" examinee_answer.deadline < ? or examinee_answer.due_at <= ? ", now, now).
+		Order(" examinee_answer.last_action_time, examinee_answer.id ").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
 // 更新最新动作
-func (r *ExamineeAnswerRepo) UpdateAction(ctx context.Context, examineeAnswerId string, lastActionTime, lastActionTime2 time.Time, remaining int32, completeQuestionNum int32) (int64, error) {
+func (r *ExamineeAnswerRepo) UpdateAction(ctx context.Context, examineeAnswerId string, lastActionTime, lastActionTime2 time.Time, remaining int32) (int64, error) {
 	// 准备更新字段
 	updates := map[string]interface{}{
-		"last_action_time":      lastActionTime,
-		"remaining_timelimit":   remaining,
-		"complete_question_num": completeQuestionNum,
-		"updated_by":            "service",
+		"last_action_time":    lastActionTime,
+		"remaining_timelimit": remaining,
+		"due_at":              lastActionTime.Add(time.Duration(remaining) * time.Second),
+		"updated_by":          "service",
 	}
 	// 执行更新
 	res := r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{}).
@@ -59,6 +91,17 @@ func (r *ExamineeAnswerRepo) UpdateAction(ctx context.Context, examineeAnswerId
 	return res.RowsAffected, res.Error
 }
 
+// 更新已作答题目数量
+func (r *ExamineeAnswerRepo) UpdateCompleteQuestionNum(ctx context.Context, examineeAnswerId string, completeQuestionNum int32) error {
+	updates := map[string]interface{}{
+		"complete_question_num": completeQuestionNum,
+		"updated_by":            "service",
+	}
+	return r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{}).
+		Where(" id = ? ", examineeAnswerId).
+		Updates(updates).Error
+}
+
 // 更新结果
 func (r *ExamineeAnswerRepo) UpdateResult(ctx context.Context, examineeAnswerId string, score float64, comparability, usability int32) error {
 	// 准备更新字段
@@ -76,13 +119,54 @@ func (r *ExamineeAnswerRepo) UpdateResult(ctx context.Context, examineeAnswerId
 	return err
 }
 
+// 删除作答记录（逻辑删除），用于练习重新开始
+func (r *ExamineeAnswerRepo) Delete(ctx context.Context, examineeAnswerId string) error {
+	return r.data.db.WithContext(ctx).Where(" id = ? ", examineeAnswerId).Delete(&entity.ExamineeAnswer{}).Error
+}
+
+// 更新分部作答状态（乐观锁：状态未被其他请求修改时才更新）
+func (r *ExamineeAnswerRepo) UpdateSectionState(ctx context.Context, examineeAnswerId string, sectionState, oldSectionState string) (int64, error) {
+	res := r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{}).
+		Where(" id = ? and section_state = ? ", examineeAnswerId, oldSectionState).
+		Updates(map[string]interface{}{
+			"section_state": sectionState,
+			"updated_by":    "service",
+		})
+	return res.RowsAffected, res.Error
+}
+
+// 更新自适应作答状态和已下发的题目（乐观锁：状态未被其他请求修改时才更新）
+func (r *ExamineeAnswerRepo) UpdateAdaptiveState(ctx context.Context, examineeAnswerId string, adaptiveState, questionIds, oldAdaptiveState string) (int64, error) {
+	res := r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{}).
+		Where(" id = ? and adaptive_state = ? ", examineeAnswerId, oldAdaptiveState).
+		Updates(map[string]interface{}{
+			"adaptive_state": adaptiveState,
+			"question_ids":   questionIds,
+			"updated_by":     "service",
+		})
+	return res.RowsAffected, res.Error
+}
+
+// 更新作答诚信分析结果
+func (r *ExamineeAnswerRepo) UpdateIntegrity(ctx context.Context, examineeAnswerId string, integrityScore float64, integrityFlags string) error {
+	updates := map[string]interface{}{
+		"integrity_score": integrityScore,
+		"integrity_flags": integrityFlags,
+		"updated_by":      "service",
+	}
+	return r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswer{}).
+		Where(" id = ? ", examineeAnswerId).
+		Updates(updates).Error
+}
+
 // 提交试卷
-func (r *ExamineeAnswerRepo) SubmitResult(ctx context.Context, examineeAnswerId string, score float64, comparability, usability int32) error {
+func (r *ExamineeAnswerRepo) SubmitResult(ctx context.Context, examineeAnswerId string, submitTime time.Time, remaining int32, completeQuestionNum int32) error {
 	// 准备更新字段
 	updates := map[string]interface{}{
-		"submit_time":           score,
-		"complete_question_num": comparability,
-		"last_action_time":      usability,
+		"submit_time":           submitTime,
+		"last_action_time":      submitTime,
+		"remaining_timelimit":   remaining,
+		"complete_question_num": completeQuestionNum,
 		"updated_by":            "service",
 	}
 	// 执行更新
@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+	"exam_api/internal/biz"
+	"exam_api/internal/data/entity"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type ExamineeAnswerDimensionScoreRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewExamineeAnswerDimensionScoreRepo(data *Data, logger log.Logger) biz.ExamineeAnswerDimensionScoreRepo {
+	return &ExamineeAnswerDimensionScoreRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ExamineeAnswerDimensionScoreRepo) GetByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamineeAnswerDimensionScore, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswerDimensionScore{}).Where(" examinee_answer_id = ? ", examineeAnswerId).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// 保存维度得分（重新算分时覆盖旧记录）
+func (r *ExamineeAnswerDimensionScoreRepo) SaveScores(ctx context.Context, examineeAnswerId string, scores []*entity.ExamineeAnswerDimensionScore) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Where(" examinee_answer_id = ? ", examineeAnswerId).Delete(&entity.ExamineeAnswerDimensionScore{}).Error
+		if err != nil {
+			return err
+		}
+		if len(scores) == 0 {
+			return nil
+		}
+		return tx.Create(&scores).Error
+	})
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"exam_api/internal/data/entity"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestGetOverdueListPaging(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	last := &entity.ExamineeAnswer{ID: "EA9", LastActionTime: now.Add(-time.Hour)}
+	cases := []struct {
+		name  string
+		after *entity.ExamineeAnswer
+		query string
+		args  int
+	}{
+		{"第一页", nil, "ORDER BY examinee_answer.last_action_time, examinee_answer.id LIMIT \\?", 4},
+		{"按上一页最后一条翻页", last, `examinee_answer.last_action_time > \? or \(examinee_answer.last_action_time = \? and examinee_answer.id > \?\).*ORDER BY examinee_answer.last_action_time, examinee_answer.id LIMIT \?`, 7},
+	}
+	for _, c := range cases {
+		data, mock := newMockData(t)
+		repo := NewExamineeAnswerRepo(data, log.DefaultLogger)
+		args := make([]driver.Value, c.args)
+		for i := range args {
+			args[i] = sqlmock.AnyArg()
+		}
+		mock.ExpectQuery(c.query).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		if _, err := repo.GetOverdueList(context.Background(), now, c.after, 100); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+	}
+}
+
+// 考生离开后不再心跳，剩余时间多于 30 秒的作答也按 due_at 选出自动交卷
+func TestGetOverdueListByDueAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	data, mock := newMockData(t)
+	repo := NewExamineeAnswerRepo(data, log.DefaultLogger)
+	mock.ExpectQuery(`examinee_answer.deadline < \? or examinee_answer.due_at <= \?`).
+		WithArgs(sqlmock.AnyArg(), now, now, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "remaining_timelimit", "due_at"}).AddRow("EA1", 600, now.Add(-time.Minute)))
+	list, err := repo.GetOverdueList(context.Background(), now, nil, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID != "EA1" {
+		t.Fatalf("got %v", list)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateActionSetsDueAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	data, mock := newMockData(t)
+	repo := NewExamineeAnswerRepo(data, log.DefaultLogger)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `examinee_answer` SET .*`due_at`=\\?").
+		WithArgs(now.Add(570*time.Second), now, 570, "service", sqlmock.AnyArg(), "EA1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if _, err := repo.UpdateAction(context.Background(), "EA1", now, now.Add(-30*time.Second), 570); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 type ExamineeQuestionAnswerRepo struct {
@@ -29,13 +30,153 @@ func (r *ExamineeQuestionAnswerRepo) GetByExamineeAnswerId(ctx context.Context,
 	return list, nil
 }
 
-func (r *ExamineeQuestionAnswerRepo) SaveAnswer(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer) error {
-	return r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
-		Columns: []clause.Column{{Name: "examinee_answer_id"}, {Name: "question_id"}}, // 唯一索引字段
-		DoUpdates: clause.Assignments(map[string]interface{}{
-			"option_sign": clause.Column{Table: "", Name: "option_sign"},
-			"created_by":  gorm.Expr("VALUES(`created_by`)"),
-			"updated_by":  gorm.Expr("VALUES(`updated_by`)"),
-		}),
-	}).Create(&answers).Error
+// 保存答案：答案表只保留每题最新的答案（物化的当前值），答案发生变化的题目追加一条修改记录，
+// 序号在事务内按题目取当前最大序号递增，修改记录只增不改，保留完整的作答轨迹
+func (r *ExamineeQuestionAnswerRepo) SaveAnswer(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer, revisions []*entity.ExamineeAnswerQuestionRevision) error {
+	if len(answers) == 0 {
+		return nil
+	}
+	examineeAnswerId := answers[0].ExamineeAnswerID
+	questionIds := make([]string, 0, len(answers))
+	for _, answer := range answers {
+		questionIds = append(questionIds, answer.QuestionID)
+	}
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁住当前答案，与同一作答的并发保存串行
+		var current []*entity.ExamineeAnswerQuestionAnswer
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(" examinee_answer_id = ? AND question_id IN ? ", examineeAnswerId, questionIds).
+			Find(&current).Error
+		if err != nil {
+			return err
+		}
+		currentSign := make(map[string][2]string, len(current))
+		for _, answer := range current {
+			currentSign[answer.QuestionID] = [2]string{answer.OptionSign, answer.TextAnswer}
+		}
+		changed := make([]*entity.ExamineeAnswerQuestionRevision, 0, len(revisions))
+		for _, revision := range revisions {
+			sign := [2]string{revision.OptionSign, revision.TextAnswer}
+			if old, ok := currentSign[revision.QuestionID]; ok && old == sign {
+				continue
+			}
+			// 同一批次内同一题目多次出现时，以后出现的为准并各自记录
+			currentSign[revision.QuestionID] = sign
+			changed = append(changed, revision)
+		}
+		err = tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "examinee_answer_id"}, {Name: "question_id"}}, // 唯一索引字段
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"option_sign": gorm.Expr("VALUES(`option_sign`)"),
+				"text_answer": gorm.Expr("VALUES(`text_answer`)"),
+				// 用时和修改次数由客户端累计上报，乱序到达的旧心跳不能把数值改小
+				"time_spent_ms": gorm.Expr("GREATEST(`time_spent_ms`, VALUES(`time_spent_ms`))"),
+				"change_count":  gorm.Expr("GREATEST(`change_count`, VALUES(`change_count`))"),
+				"created_by":    gorm.Expr("VALUES(`created_by`)"),
+				"updated_by":    gorm.Expr("VALUES(`updated_by`)"),
+			}),
+		}).Create(&answers).Error
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		var seqs []struct {
+			QuestionID string
+			Seq        int32
+		}
+		err = tx.Model(&entity.ExamineeAnswerQuestionRevision{}).
+			Select(" question_id, MAX(seq) AS seq ").
+			Where(" examinee_answer_id = ? AND question_id IN ? ", examineeAnswerId, questionIds).
+			Group(" question_id ").
+			Scan(&seqs).Error
+		if err != nil {
+			return err
+		}
+		lastSeq := make(map[string]int32, len(seqs))
+		for _, seq := range seqs {
+			lastSeq[seq.QuestionID] = seq.Seq
+		}
+		for _, revision := range changed {
+			lastSeq[revision.QuestionID]++
+			revision.Seq = lastSeq[revision.QuestionID]
+		}
+		return tx.Create(&changed).Error
+	})
+}
+
+// 按作答获取答案修改记录，按服务端接收时间和序号排序
+func (r *ExamineeQuestionAnswerRepo) GetRevisionsByExamineeAnswerId(ctx context.Context, examineeAnswerId string) (list []*entity.ExamineeAnswerQuestionRevision, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswerQuestionRevision{}).
+		Where(" examinee_answer_id = ? ", examineeAnswerId).
+		Order(" received_at, question_id, seq ").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *ExamineeQuestionAnswerRepo) GetById(ctx context.Context, id string) (resEntity *entity.ExamineeAnswerQuestionAnswer, err error) {
+	return getSingleRecordByScope[entity.ExamineeAnswerQuestionAnswer](
+		r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswerQuestionAnswer{}).Where(" id = ? ", id),
+	)
+}
+
+// 保存评分结果（得分、评分状态）
+func (r *ExamineeQuestionAnswerRepo) UpdateGrades(ctx context.Context, answers []*entity.ExamineeAnswerQuestionAnswer) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, answer := range answers {
+			updates := map[string]interface{}{
+				"score":        answer.Score,
+				"grade_status": answer.GradeStatus,
+				"updated_by":   "service",
+			}
+			err := tx.Model(&entity.ExamineeAnswerQuestionAnswer{}).Where(" id = ? ", answer.ID).Updates(updates).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// 人工评分，只更新仍处于待评分状态的答案，返回是否更新成功
+func (r *ExamineeQuestionAnswerRepo) UpdateManualGrade(ctx context.Context, id string, score float64, gradedBy string, gradedAt time.Time) (bool, error) {
+	updates := map[string]interface{}{
+		"score":        score,
+		"grade_status": biz.GradeStatusGraded,
+		"graded_by":    gradedBy,
+		"graded_at":    gradedAt,
+		"updated_by":   gradedBy,
+	}
+	res := r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswerQuestionAnswer{}).
+		Where(" id = ? and grade_status = ? ", id, biz.GradeStatusPending).
+		Updates(updates)
+	return res.RowsAffected > 0, res.Error
+}
+
+// 分页获取待人工评分的答案
+func (r *ExamineeQuestionAnswerRepo) GetPendingGradingPageList(ctx context.Context, pageIndex, pageSize int32) (list []*entity.ExamineeAnswerQuestionAnswer, total int64, err error) {
+	session := r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswerQuestionAnswer{}).
+		Where(" grade_status = ? ", biz.GradeStatusPending)
+	session.Count(&total)
+	err = session.
+		Order("created_at asc").
+		Offset(int((pageIndex - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&list).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// 统计答卷中仍待人工评分的答案数量
+func (r *ExamineeQuestionAnswerRepo) CountPendingGrading(ctx context.Context, examineeAnswerId string) (count int64, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.ExamineeAnswerQuestionAnswer{}).
+		Where(" examinee_answer_id = ? and grade_status = ? ", examineeAnswerId, biz.GradeStatusPending).
+		Count(&count).Error
+	return
 }
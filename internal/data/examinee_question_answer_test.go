@@ -0,0 +1,56 @@
+package data
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"exam_api/internal/data/entity"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSaveAnswerOverwritesLatestAnswer(t *testing.T) {
+	data, mock := newMockData(t)
+	repo := NewExamineeQuestionAnswerRepo(data, log.DefaultLogger)
+	save := func(sign string) error {
+		answers := []*entity.ExamineeAnswerQuestionAnswer{{ID: "EAQAP1", ExamineeAnswerID: "EAP1", QuestionID: "Q1", OptionSign: sign}}
+		revisions := []*entity.ExamineeAnswerQuestionRevision{{ID: "EAQRP" + sign, ExamineeAnswerID: "EAP1", QuestionID: "Q1", OptionSign: sign, ReceivedAt: time.Now()}}
+		return repo.SaveAnswer(context.Background(), answers, revisions)
+	}
+	// 唯一键冲突时答案取本次插入的值，而不是保留原值
+	upsert := regexp.QuoteMeta("ON DUPLICATE KEY UPDATE") + ".*" +
+		regexp.QuoteMeta("`option_sign`=VALUES(`option_sign`)") + ".*" +
+		regexp.QuoteMeta("`text_answer`=VALUES(`text_answer`)")
+
+	// 第一次保存
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT .* FROM `examinee_answer_question_answer` .* FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "question_id", "option_sign", "text_answer"}))
+	mock.ExpectExec("INSERT INTO `examinee_answer_question_answer` .*" + upsert).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT question_id, MAX\\(seq\\) AS seq").WillReturnRows(sqlmock.NewRows([]string{"question_id", "seq"}))
+	mock.ExpectExec("INSERT INTO `examinee_answer_question_revision`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err := save(`["A"]`); err != nil {
+		t.Fatalf("first save: %v", err)
+	}
+
+	// 第二次保存不同的答案：覆盖答案表并追加第2条修改记录
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT .* FROM `examinee_answer_question_answer` .* FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "question_id", "option_sign", "text_answer"}).AddRow("EAQAP1", "Q1", `["A"]`, ""))
+	mock.ExpectExec("INSERT INTO `examinee_answer_question_answer` .*" + upsert).
+		WithArgs(anyArgs(4, `["B"]`, 9)...).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery("SELECT question_id, MAX\\(seq\\) AS seq").
+		WillReturnRows(sqlmock.NewRows([]string{"question_id", "seq"}).AddRow("Q1", 1))
+	mock.ExpectExec("INSERT INTO `examinee_answer_question_revision`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err := save(`["B"]`); err != nil {
+		t.Fatalf("second save: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"exam_api/internal/data/entity"
 	"exam_api/internal/model"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 	"strings"
+	"time"
 )
 
 type ExamineeSalesPaperAssociationRepo struct {
@@ -78,6 +80,41 @@ func (r *ExamineeSalesPaperAssociationRepo) UpdateStageNumber(ctx context.Contex
 		}).Error
 }
 
+// 更新为处理失败并记录原因
+func (r *ExamineeSalesPaperAssociationRepo) UpdateFailed(ctx context.Context, examineeSalesPaperAssociationId string, reason string) (err error) {
+	return r.data.db.WithContext(ctx).Model(&entity.ExamineeSalesPaperAssociation{}).
+		Where(" id = ? ", examineeSalesPaperAssociationId).
+		Updates(map[string]interface{}{
+			"stage_number": v1.StageNumber_Failed,
+			"fail_reason":  reason,
+		}).Error
+}
+
+// 获取待重新算分的考试：已提交、待人工评分或处理失败，before 之前没有更新过且重新算分次数少于 maxTimes，按 id 翻页
+func (r *ExamineeSalesPaperAssociationRepo) GetRescoreList(ctx context.Context, before time.Time, maxTimes int32, afterId string, limit int) (list []*entity.ExamineeSalesPaperAssociation, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.ExamineeSalesPaperAssociation{}).
+		Where(" stage_number in ? ", []v1.StageNumber{v1.StageNumber_Submit, v1.StageNumber_AwaitingGrading, v1.StageNumber_Failed}).
+		Where(" updated_at < ? ", before).
+		Where(" rescore_times < ? ", maxTimes).
+		Where(" id > ? ", afterId).
+		Order(" id ").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// 重新算分次数加1
+func (r *ExamineeSalesPaperAssociationRepo) IncrRescoreTimes(ctx context.Context, examineeSalesPaperAssociationId string) (err error) {
+	return r.data.db.WithContext(ctx).Model(&entity.ExamineeSalesPaperAssociation{}).
+		Where(" id = ? ", examineeSalesPaperAssociationId).
+		Updates(map[string]interface{}{
+			"rescore_times": gorm.Expr("rescore_times + 1"),
+		}).Error
+}
+
 func (r *ExamineeSalesPaperAssociationRepo) buildConditions(examineeId string) (string, []interface{}) {
 	var (
 		query strings.Builder
@@ -0,0 +1,45 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestGetRescoreList(t *testing.T) {
+	data, mock := newMockData(t)
+	repo := NewExamineeSalesPaperAssociationRepo(data, log.DefaultLogger)
+	before := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	mock.ExpectQuery(`stage_number in \(\?,\?,\?\).*updated_at < \?.*rescore_times < \?.*id > \?.*deleted_at. IS NULL ORDER BY id LIMIT \?`).
+		WithArgs(2, 6, 5, before, 5, "ESPA9", 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "stage_number"}).AddRow("ESPA10", 5))
+	list, err := repo.GetRescoreList(context.Background(), before, 5, "ESPA9", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID != "ESPA10" {
+		t.Fatalf("got %v", list)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIncrRescoreTimes(t *testing.T) {
+	data, mock := newMockData(t)
+	repo := NewExamineeSalesPaperAssociationRepo(data, log.DefaultLogger)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `examinee_sales_paper_association` SET `rescore_times`=rescore_times \\+ 1").
+		WithArgs(sqlmock.AnyArg(), "ESPA1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err := repo.IncrRescoreTimes(context.Background(), "ESPA1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -94,6 +94,22 @@ func (r *QuestionRepo) GetOptionListByQuestionIds(ctx context.Context, questionI
 	}
 	return
 }
+func (r *QuestionRepo) GetAttachmentListByQuestionIds(ctx context.Context, questionIds []string) (res map[string][]*entity.QuestionAttachment, err error) {
+	attachments := make([]*entity.QuestionAttachment, 0)
+	res = make(map[string][]*entity.QuestionAttachment)
+	err = r.data.db.WithContext(ctx).Model(&entity.QuestionAttachment{}).
+		Where(" question_id in ?", questionIds).
+		Order(" `order` asc").
+		Find(&attachments).Error
+	if err != nil {
+		return
+	}
+	for _, attachment := range attachments {
+		res[attachment.QuestionID] = append(res[attachment.QuestionID], attachment)
+	}
+	return
+}
+
 func (r *QuestionRepo) GetById(ctx context.Context, questionId string) (qEntity *entity.Question, qOptionsEntities []*entity.QuestionOption, err error) {
 	d := r.data.db.WithContext(ctx)
 	qEntity, err = getSingleRecordByScope[entity.Question](
@@ -106,6 +122,14 @@ func (r *QuestionRepo) GetById(ctx context.Context, questionId string) (qEntity
 	return
 }
 
+func (r *QuestionRepo) GetByIds(ctx context.Context, questionIds []string) (list []*entity.Question, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.Question{}).Where(" id in ? ", questionIds).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
 func (r *QuestionRepo) buildConditions(salesPaperId string) (string, []interface{}) {
 	var (
 		query strings.Builder
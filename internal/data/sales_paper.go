@@ -28,3 +28,51 @@ func (r *SalesPaperRepo) GetByID(ctx context.Context, salesPaperId string) (resE
 	}
 	return resEntity, nil
 }
+
+func (r *SalesPaperRepo) GetByIDs(ctx context.Context, salesPaperIds []string) (list []*entity.SalesPaper, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.SalesPaper{}).Where(" id in ? ", salesPaperIds).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SalesPaperRepo) GetDrawRulesBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperDrawRule, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.SalesPaperDrawRule{}).Where(" sales_paper_id = ? ", salesPaperId).Order(" created_at ").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SalesPaperRepo) GetSectionListBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperSection, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.SalesPaperSection{}).Where(" sales_paper_id = ? ", salesPaperId).Order(" `order` asc ").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SalesPaperRepo) GetDimensionListBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperDimension, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.SalesPaperDimension{}).Where(" sales_paper_id = ? ", salesPaperId).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SalesPaperRepo) GetCommentListBySalesPaperId(ctx context.Context, salesPaperId string) (list []*entity.SalesPaperComment, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.SalesPaperComment{}).Where(" sales_paper_id = ? ", salesPaperId).Order(" low_score asc ").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SalesPaperRepo) GetDimensionCommentListByDimensionIds(ctx context.Context, dimensionIds []string) (list []*entity.SalesPaperDimensionComment, err error) {
+	err = r.data.db.WithContext(ctx).Model(&entity.SalesPaperDimensionComment{}).Where(" sales_paper_dimension_id in ? ", dimensionIds).Order(" low_score asc ").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
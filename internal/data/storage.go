@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"exam_api/api/common"
+	"exam_api/internal/biz"
+	"exam_api/internal/conf"
+	"exam_api/internal/pkg/istorage"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 签名地址默认有效期
+const defaultURLExpire = 10 * time.Minute
+
+type StorageRepo struct {
+	storage istorage.Storage
+	expire  time.Duration
+	log     *log.Helper
+}
+
+// LocalStorageRepo 本地文件存储，同时作为 http.Handler 提供签名地址的文件访问
+type LocalStorageRepo struct {
+	*StorageRepo
+	local *istorage.Local
+}
+
+// NewStorageRepo 按 Data.Storage 创建存储，签名地址使用服务统一配置的对象存储 domain（common.ServerConfig.oss_domain），
+// 未配置时返回本服务的相对地址
+func NewStorageRepo(s *common.ServerConfig, c *conf.Data, logger log.Logger) (biz.StorageRepo, error) {
+	config := c.Storage
+	if config == nil {
+		config = &conf.Data_Storage{}
+	}
+	repo := &StorageRepo{expire: defaultURLExpire, log: log.NewHelper(logger)}
+	if config.UrlExpireSeconds > 0 {
+		repo.expire = time.Duration(config.UrlExpireSeconds) * time.Second
+	}
+	switch config.Driver {
+	case "":
+		// 未配置存储时照常启动，签名时报错
+		return repo, nil
+	case "local":
+		local, err := istorage.NewLocal(config.LocalRoot, s.GetOssDomain(), config.Secret)
+		if err != nil {
+			return nil, err
+		}
+		repo.storage = local
+		return &LocalStorageRepo{StorageRepo: repo, local: local}, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", config.Driver)
+	}
+}
+
+func (r *LocalStorageRepo) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.local.ServeHTTP(w, req)
+}
+
+func (r *StorageRepo) SignURL(ctx context.Context, key string) (signedURL string, expiresAt time.Time, err error) {
+	if r.storage == nil {
+		err = errors.New("storage is not configured")
+		return
+	}
+	return r.storage.SignURL(key, r.expire)
+}
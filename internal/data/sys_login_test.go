@@ -0,0 +1,28 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"exam_api/internal/data/entity"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSysLoginRepoCreateKeepsFailure(t *testing.T) {
+	data, mock := newMockData(t)
+	repo := NewSysLoginRepo(data, log.DefaultLogger)
+	// 登录失败记录的 is_success 必须按 false 写入，不能被默认值替换
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `sys_login_record` .*`is_success`").
+		WithArgs(anyArgs(4, false, 3)...).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err := repo.Create(context.Background(), &entity.SysLoginRecord{ID: "SLR1", UserID: "EP1", LoginPlatform: 2, LoginAccount: "a", FailReason: "密码错误"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	_const "exam_api/internal/const"
 	"exam_api/internal/pkg/iclient"
 	"exam_api/internal/pkg/icontext"
+	innErr "exam_api/internal/pkg/ierrors"
 	"exam_api/internal/pkg/iheader"
 	"exam_api/internal/pkg/ijwt"
 	"github.com/airunny/wiki-go-tools/reqid"
@@ -15,7 +16,9 @@ import (
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/gorilla/handlers"
+	"net"
 	stdHttp "net/http"
+	"net/netip"
 	"strings"
 	"time"
 )
@@ -63,7 +66,7 @@ func RequestIdWithHeader(handler middleware.Handler) middleware.Handler {
 func TryParseHeader(opts ...Option) middleware.Middleware {
 	o := Options{}
 	for _, opt := range opts {
-		opt(o)
+		opt(&o)
 	}
 
 	return func(handler middleware.Handler) middleware.Handler {
@@ -78,7 +81,7 @@ func TryParseHeader(opts ...Option) middleware.Middleware {
 			method := tr.Operation()
 
 			// 判断是否是需要跳过的接口
-			if method == "/exam_api.v1.ExamService/ExamLogin" {
+			if _, ok := _const.SkipAccessTokenMethod[method]; ok {
 				return handler(ctx, req)
 			}
 
@@ -96,6 +99,16 @@ func TryParseHeader(opts ...Option) middleware.Middleware {
 				// JWT 国旗
 				return nil, errors.Unauthorized("Unauthorized", " token expiration ")
 			}
+			// 检查是否已吊销（退出登录）
+			if o.revocation != nil && claims.Jti != "" {
+				revoked, err := o.revocation.IsTokenRevoked(ctx, claims.Jti)
+				if err != nil {
+					return nil, errors.InternalServer("InternalServer", "check token failed")
+				}
+				if revoked {
+					return nil, errors.Unauthorized("Unauthorized", " token revoked ")
+				}
+			}
 			// 用户ID
 			ctx = icontext.WithUserIdKey(ctx, claims.UserID)
 			// 用户名
@@ -111,7 +124,12 @@ func TryParseHeader(opts ...Option) middleware.Middleware {
 	}
 }
 
-func AuthExamTokenMiddleware() middleware.Middleware {
+func AuthExamTokenMiddleware(opts ...Option) middleware.Middleware {
+	o := Options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			tr, ok := transport.FromServerContext(ctx)
@@ -122,7 +140,7 @@ func AuthExamTokenMiddleware() middleware.Middleware {
 			clientInfo := &iclient.ClientInfo{}
 			if ok {
 				clientInfo = &iclient.ClientInfo{
-					IP:         getClientIP(tr),
+					IP:         getClientIP(tr, o.proxies),
 					UserAgent:  header.Get("User-Agent"),
 					Accept:     header.Get("Accept"),
 					AcceptLang: header.Get("Accept-Language"),
@@ -146,7 +164,13 @@ func AuthExamTokenMiddleware() middleware.Middleware {
 				// 返回 Unauthorized 错误
 				return nil, errors.Unauthorized("Unauthorized", "missing or invalid exam token")
 			}
-			claims, err := JWT.VerifyExamToken(jwt, examJwt, clientInfo)
+			claims, mismatch, err := JWT.VerifyExamToken(jwt, examJwt, clientInfo)
+			// 指纹变化无论是否放行都记录下来，供监考复核
+			if mismatch != nil && claims != nil && o.fingerprint != nil {
+				recordCtx := icontext.WithSessionIdKey(ctx, claims.SessionID)
+				recordCtx = icontext.WithExamTokenKey(recordCtx, examJwt)
+				o.fingerprint.RecordFingerprintMismatch(recordCtx, claims.AssociationId, mismatch)
+			}
 			if err != nil || claims == nil || claims.AssociationId == "" || claims.UserID == "" {
 				// JWT 解析失败
 				return nil, errors.Unauthorized("Unauthorized", "invalid token: "+err.Error())
@@ -154,6 +178,16 @@ func AuthExamTokenMiddleware() middleware.Middleware {
 			if claims.ExpiresAt < time.Now().Unix() {
 				return nil, errors.Unauthorized("Unauthorized", " exam token expiration ")
 			}
+			// 同一场考试只允许一个有效会话
+			if o.session != nil {
+				active, err := o.session.CheckActiveSession(ctx, claims.AssociationId, claims.SessionID)
+				if err != nil {
+					return nil, errors.InternalServer("InternalServer", "check exam session failed")
+				}
+				if !active {
+					return nil, innErr.ErrExamSessionReplaced
+				}
+			}
 			ctx = icontext.WithAssociationIdKey(ctx, claims.AssociationId)
 			ctx = icontext.WithSessionIdKey(ctx, claims.SessionID)
 			ctx = icontext.WithExamTokenKey(ctx, examJwt)
@@ -206,26 +240,53 @@ func WriteResponse(w http.ResponseWriter, _ *stdHttp.Request, body interface{})
 }
 
 // 获取客户端IP
-func getClientIP(tr transport.Transporter) string {
-	// 检查代理头
-	host := ""
-	if ip := tr.RequestHeader().Get("X-Forwarded-For"); ip != "" {
-		return strings.Split(ip, ",")[0]
-	}
-	if ip := tr.RequestHeader().Get("X-Real-Ip"); ip != "" {
-		return ip
-	}
-
-	// 使用远程地址
+func getClientIP(tr transport.Transporter, proxies []netip.Prefix) string {
+	remoteAddr := ""
 	if ht, ok := tr.(*http.Transport); ok {
-		// 获取 *http.Request
-		httpRequest := ht.Request()
-		if httpRequest != nil {
-			host, _, _ = strings.Cut(httpRequest.RemoteAddr, ":")
-			// 你可以选择将 remoteAddr 存入 ctx 供后续 handler 使用
-			// ctx = context.WithValue(ctx, "remote_addr", remoteAddr)
+		if httpRequest := ht.Request(); httpRequest != nil {
+			remoteAddr = httpRequest.RemoteAddr
 		}
 	}
+	header := tr.RequestHeader()
+	return resolveClientIP(remoteAddr, header.Get("X-Forwarded-For"), header.Get("X-Real-Ip"), proxies)
+}
 
+// resolveClientIP 直连地址是可信代理时，从 X-Forwarded-For 右侧跳过可信代理取第一个地址，
+// 否则直接使用直连地址，避免客户端伪造代理头绕过按IP的限制
+func resolveClientIP(remoteAddr, forwardedFor, realIp string, proxies []netip.Prefix) string {
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+	if !isTrustedProxy(host, proxies) {
+		return host
+	}
+	if forwardedFor != "" {
+		ips := strings.Split(forwardedFor, ",")
+		for i := len(ips) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(ips[i])
+			if ip != "" && !isTrustedProxy(ip, proxies) {
+				return ip
+			}
+		}
+		return strings.TrimSpace(ips[0])
+	}
+	if realIp != "" {
+		return realIp
+	}
 	return host
 }
+
+func isTrustedProxy(ip string, proxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, proxy := range proxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"testing"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		realIp       string
+		want         string
+	}{
+		{"直连不采信代理头", "1.2.3.4:5678", "9.9.9.9", "8.8.8.8", "1.2.3.4"},
+		{"可信代理取最右侧非代理地址", "10.0.0.2:80", "9.9.9.9, 1.2.3.4, 10.0.0.3", "", "1.2.3.4"},
+		{"可信代理单个IP", "192.168.1.1:80", "1.2.3.4", "", "1.2.3.4"},
+		{"全部是可信代理取最左侧", "10.0.0.2:80", "10.0.0.5, 10.0.0.3", "", "10.0.0.5"},
+		{"可信代理使用 X-Real-Ip", "10.0.0.2:80", "", "1.2.3.4", "1.2.3.4"},
+		{"可信代理没有代理头", "10.0.0.2:80", "", "", "10.0.0.2"},
+		{"IPv6直连", "[2001:db8::1]:443", "9.9.9.9", "", "2001:db8::1"},
+	}
+	for _, c := range cases {
+		if got := resolveClientIP(c.remoteAddr, c.forwardedFor, c.realIp, proxies); got != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+	if got := resolveClientIP("10.0.0.2:80", "9.9.9.9", "", nil); got != "10.0.0.2" {
+		t.Errorf("未配置可信代理: got %q", got)
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	if _, err := ParseTrustedProxies([]string{"not-an-ip"}); err == nil {
+		t.Error("invalid proxy should fail")
+	}
+	if _, err := ParseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("invalid prefix should fail")
+	}
+}
@@ -2,6 +2,10 @@ package middleware
 
 import (
 	"context"
+	"exam_api/internal/pkg/ijwt"
+	"fmt"
+	"net/netip"
+	"strings"
 )
 
 type CountryCodeConvert interface {
@@ -13,14 +17,80 @@ type TextPlainReply interface {
 	StringReply() string
 }
 
+// TokenRevocation 查询主令牌是否已被吊销
+type TokenRevocation interface {
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// FingerprintRecorder 记录考试令牌客户端指纹变化
+type FingerprintRecorder interface {
+	RecordFingerprintMismatch(ctx context.Context, associationId string, mismatch *ijwt.FingerprintMismatch)
+}
+
+// ExamSessionChecker 校验考试令牌的会话是否为当前有效会话
+type ExamSessionChecker interface {
+	CheckActiveSession(ctx context.Context, associationId, sessionId string) (bool, error)
+}
+
 type Options struct {
-	convert CountryCodeConvert
+	convert     CountryCodeConvert
+	revocation  TokenRevocation
+	fingerprint FingerprintRecorder
+	session     ExamSessionChecker
+	proxies     []netip.Prefix
 }
 
-type Option func(options Options)
+type Option func(options *Options)
 
 func WithCountryCodeConvert(convert CountryCodeConvert) Option {
-	return func(o Options) {
+	return func(o *Options) {
 		o.convert = convert
 	}
 }
+
+func WithTokenRevocation(revocation TokenRevocation) Option {
+	return func(o *Options) {
+		o.revocation = revocation
+	}
+}
+
+func WithFingerprintRecorder(recorder FingerprintRecorder) Option {
+	return func(o *Options) {
+		o.fingerprint = recorder
+	}
+}
+
+func WithExamSessionChecker(checker ExamSessionChecker) Option {
+	return func(o *Options) {
+		o.session = checker
+	}
+}
+
+// WithTrustedProxies 可信代理，只有直连地址是可信代理时才从代理头获取客户端IP
+func WithTrustedProxies(proxies []netip.Prefix) Option {
+	return func(o *Options) {
+		o.proxies = proxies
+	}
+}
+
+// ParseTrustedProxies 解析可信代理配置，支持单个IP和CIDR
+func ParseTrustedProxies(values []string) ([]netip.Prefix, error) {
+	proxies := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+			}
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
@@ -16,6 +16,9 @@ type ExamineeSalesPaperAssociation struct {
 	EmailStatus    int32          `json:"email_status"`     // 邮件状态：1.未发送，2.已发送，3.发送失败
 	ReportPath     string         `json:"report_path"`      // 答题报告路径
 	StageNumber    int32          `json:"stage_number"`     // 阶段编号（0~4）
+	OpenAt         *time.Time     `json:"open_at"`          // 考试开放时刻
+	CloseAt        *time.Time     `json:"close_at"`         // 考试关闭时刻
+	TimeLimit      int32          `json:"time_limit"`       // 考试时长（分钟），0表示使用试卷建议时长
 	CreatedAt      time.Time      `json:"created_at"`       // 创建时间
 	UpdatedAt      time.Time      `json:"updated_at"`       // 更新时间
 	CreatedBy      string         `json:"created_by"`       // 创建人标识
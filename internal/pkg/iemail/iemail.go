@@ -2,7 +2,13 @@ package iemail
 
 import (
 	"bytes"
+	"crypto/tls"
 	_const "exam_api/internal/const"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
 	"text/template"
 )
 
@@ -36,3 +42,74 @@ func RenderEmail(data EmailData) (string, error) {
 
 	return buf.String(), nil
 }
+
+type LoginCodeData struct {
+	Name    string
+	Code    string
+	Minutes int
+}
+
+// RenderLoginCodeEmail 渲染登录验证码邮件
+func RenderLoginCodeEmail(data LoginCodeData) (string, error) {
+	tmpl, err := template.New("login_code").Parse(_const.LoginCodeEmailTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+type SmtpConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SendMail 发送纯文本邮件，465端口使用隐式TLS，其他端口由 net/smtp 按服务端能力启用STARTTLS
+func SendMail(config SmtpConfig, to, subject, body string) error {
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	auth := smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	msg := []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s",
+		config.From, to, mime.BEncoding.Encode("UTF-8", subject), body))
+	if config.Port != 465 {
+		return smtp.SendMail(addr, auth, config.From, []string{to}, msg)
+	}
+
+	conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: config.Host})
+	if err != nil {
+		return err
+	}
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err = client.Auth(auth); err != nil {
+		return err
+	}
+	if err = client.Mail(config.From); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
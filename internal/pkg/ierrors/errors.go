@@ -12,6 +12,19 @@ var (
 	ErrResourceNotFound    = errors.New(404, "RESOURCE_NOT_FOUND", "资源不存在")
 	ErrInternalServer      = errors.New(500, "INTERNAL_SERVER_ERROR", "服务内部错误")
 	ErrHeartbeat           = errors.New(429, "HEARTBEAT_ERROR", "心跳过于频繁")
+	ErrLoginFailed         = errors.New(401, "LOGIN_FAILED", "账号或密码错误")
+	ErrLoginLocked         = errors.New(429, "LOGIN_LOCKED", "登录失败次数过多，请稍后再试")
+	ErrExamNotOpen         = errors.New(403, "EXAM_NOT_OPEN", "考试尚未开放")
+	ErrExamClosed          = errors.New(403, "EXAM_CLOSED", "考试已关闭")
+	ErrExamExpired         = errors.New(403, "EXAM_EXPIRED", "该考试已过截止时间")
+	ErrExamSessionActive   = errors.New(409, "EXAM_SESSION_ACTIVE", "考试已在其他窗口或设备进行中")
+	ErrExamEventRateLimit  = errors.New(429, "EXAM_EVENT_RATE_LIMIT", "事件上报过于频繁")
+	ErrExamSessionReplaced = errors.New(401, "EXAM_SESSION_REPLACED", "考试已在其他窗口或设备打开，当前窗口已失效")
+	ErrInvalidAnswer       = errors.New(400, "INVALID_ANSWER", "答案不合法")
+	ErrUnansweredQuestions = errors.New(400, "UNANSWERED_QUESTIONS", "还有题目未作答，请全部作答后再交卷")
+	ErrGradingForbidden    = errors.New(403, "GRADING_FORBIDDEN", "没有评分权限")
+	ErrSectionLocked       = errors.New(400, "SECTION_LOCKED", "该部分已提交或已超时，不能再进入")
+	ErrSectionNotOpen      = errors.New(400, "SECTION_NOT_OPEN", "请先完成当前部分")
 )
 
 func WithReason(e *errors.Error, in string) *errors.Error {
@@ -3,6 +3,7 @@ package iformula
 import (
 	"fmt"
 	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	"math"
 )
 
@@ -11,6 +12,12 @@ type ScoreFormulaConfig struct {
 	Rounding   int32
 }
 
+// Program 预编译后的标准分公式，可重复执行
+type Program struct {
+	config  ScoreFormulaConfig
+	program *vm.Program
+}
+
 // ValidateExpression 检查给定的表达式是否合法，并可选地验证变量是否存在
 func ValidateExpression(expression string, allowedVariables map[string]interface{}) error {
 	// 编译表达式
@@ -21,20 +28,35 @@ func ValidateExpression(expression string, allowedVariables map[string]interface
 	return nil
 }
 
-// Evaluate 执行公式并返回结果
-func Evaluate(config *ScoreFormulaConfig, rawScore, averageMark, standardMark float64) (float64, error) {
+// Compile 编译公式，结果统一转换为 float64
+func Compile(config *ScoreFormulaConfig) (*Program, error) {
 	env := map[string]interface{}{
-		"raw_score":     rawScore,
-		"average_mark":  averageMark,
-		"standard_mark": standardMark,
+		"raw_score":     0.0,
+		"average_mark":  0.0,
+		"standard_mark": 0.0,
 	}
 
-	program, err := expr.Compile(config.Expression, expr.Env(env))
+	program, err := expr.Compile(config.Expression, expr.Env(env), expr.AsFloat64())
 	if err != nil {
-		return 0, fmt.Errorf("compile failed: %w", err)
+		return nil, fmt.Errorf("compile failed: %w", err)
 	}
+	return &Program{config: *config, program: program}, nil
+}
+
+// Config 返回编译时使用的公式配置
+func (p *Program) Config() ScoreFormulaConfig {
+	return p.config
+}
 
-	output, err := expr.Run(program, env)
+// Evaluate 执行已编译的公式并按配置保留小数位
+func (p *Program) Evaluate(rawScore, averageMark, standardMark float64) (float64, error) {
+	env := map[string]interface{}{
+		"raw_score":     rawScore,
+		"average_mark":  averageMark,
+		"standard_mark": standardMark,
+	}
+
+	output, err := expr.Run(p.program, env)
 	if err != nil {
 		return 0, fmt.Errorf("run failed: %w", err)
 	}
@@ -43,6 +65,23 @@ func Evaluate(config *ScoreFormulaConfig, rawScore, averageMark, standardMark fl
 	if !ok {
 		return 0, fmt.Errorf("result is not a number")
 	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, fmt.Errorf("result is not a finite number")
+	}
+
+	return Round(result, p.config.Rounding), nil
+}
+
+// Evaluate 执行公式并返回结果
+func Evaluate(config *ScoreFormulaConfig, rawScore, averageMark, standardMark float64) (float64, error) {
+	program, err := Compile(config)
+	if err != nil {
+		return 0, err
+	}
+	return program.Evaluate(rawScore, averageMark, standardMark)
+}
 
-	return math.Round(result*math.Pow(10, float64(config.Rounding))) / math.Pow(10, float64(config.Rounding)), nil
+// Round 按小数位四舍五入
+func Round(value float64, rounding int32) float64 {
+	return math.Round(value*math.Pow(10, float64(rounding))) / math.Pow(10, float64(rounding))
 }
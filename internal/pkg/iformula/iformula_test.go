@@ -0,0 +1,75 @@
+package iformula
+
+import "testing"
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{"标准分公式", "50 + 10 * (raw_score - average_mark) / standard_mark", false},
+		{"整数结果转换为小数", "raw_score > 0 ? 1 : 0", false},
+		{"未知变量", "raw_score + unknown", true},
+		{"语法错误", "50 + (raw_score", true},
+		{"结果不是数字", `"a"`, true},
+	}
+	for _, c := range cases {
+		_, err := Compile(&ScoreFormulaConfig{Expression: c.expression})
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestProgramEvaluate(t *testing.T) {
+	const standard = "50 + 10 * (raw_score - average_mark) / standard_mark"
+	cases := []struct {
+		name                    string
+		config                  ScoreFormulaConfig
+		raw, average, deviation float64
+		want                    float64
+		wantErr                 bool
+	}{
+		{"等于常模均值", ScoreFormulaConfig{standard, 2}, 30, 30, 5, 50, false},
+		{"高于均值一个标准差", ScoreFormulaConfig{standard, 2}, 35, 30, 5, 60, false},
+		{"按小数位四舍五入", ScoreFormulaConfig{standard, 2}, 31, 30, 3, 53.33, false},
+		{"不保留小数", ScoreFormulaConfig{standard, 0}, 31, 30, 3, 53, false},
+		{"标准差为0时结果不是有限数", ScoreFormulaConfig{standard, 2}, 31, 30, 0, 0, true},
+		{"整数结果", ScoreFormulaConfig{"raw_score > average_mark ? 1 : 0", 0}, 31, 30, 1, 1, false},
+	}
+	for _, c := range cases {
+		program, err := Compile(&c.config)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if program.Config() != c.config {
+			t.Errorf("%s: Config() = %+v, want %+v", c.name, program.Config(), c.config)
+		}
+		got, err := program.Evaluate(c.raw, c.average, c.deviation)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	cases := []struct {
+		value    float64
+		rounding int32
+		want     float64
+	}{
+		{53.3333, 2, 53.33},
+		{53.335, 1, 53.3},
+		{53.5, 0, 54},
+		{-1.25, 1, -1.3},
+	}
+	for _, c := range cases {
+		if got := Round(c.value, c.rounding); got != c.want {
+			t.Errorf("Round(%v, %v) = %v, want %v", c.value, c.rounding, got, c.want)
+		}
+	}
+}
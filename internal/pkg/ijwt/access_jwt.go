@@ -42,6 +42,35 @@ var (
 	ErrFingerprintChange = errors.New("client fingerprint changed")
 )
 
+// 指纹校验策略
+type FingerprintPolicy string
+
+const (
+	FingerprintStrict   FingerprintPolicy = "strict"   // 指纹任意变化都拒绝
+	FingerprintTolerant FingerprintPolicy = "tolerant" // 只要求 UserAgent 和平台一致
+	FingerprintLogOnly  FingerprintPolicy = "log_only" // 只记录不拒绝
+)
+
+// ParseFingerprintPolicy 解析配置的指纹校验策略，未配置时为 strict，不认识的策略返回错误
+func ParseFingerprintPolicy(policy string) (FingerprintPolicy, error) {
+	switch p := FingerprintPolicy(policy); p {
+	case "":
+		return FingerprintStrict, nil
+	case FingerprintStrict, FingerprintTolerant, FingerprintLogOnly:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown fingerprint policy: %q", policy)
+}
+
+// 指纹不一致详情
+type FingerprintMismatch struct {
+	Policy    FingerprintPolicy   // 生效的策略
+	Allowed   bool                // 按策略是否放行
+	Original  *iclient.ClientInfo // 签发考试令牌时的客户端信息
+	Current   *iclient.ClientInfo // 本次请求的客户端信息
+	CurrentFP string              // 本次请求的客户端指纹，用于对同一变化去重
+}
+
 // 会话存储结构
 type SessionStore struct {
 	sync.RWMutex
@@ -50,13 +79,14 @@ type SessionStore struct {
 
 // 考试会话声明
 type ExamSessionClaims struct {
-	SessionID     string `json:"sid"` // 会话ID
-	AssociationId string `json:"eid"` // 考试ID
-	UserID        string `json:"uid"` // 用户ID
-	ClientFP      string `json:"cfp"` // 客户端指纹
-	IssuedAt      int64  `json:"iat"` // 签发时间
-	ExpiresAt     int64  `json:"exp"` // 过期时间
-	NotBefore     int64  `json:"nbf"` // 生效时间
+	SessionID     string              `json:"sid"`           // 会话ID
+	AssociationId string              `json:"eid"`           // 考试ID
+	UserID        string              `json:"uid"`           // 用户ID
+	ClientFP      string              `json:"cfp"`           // 客户端指纹
+	Client        *iclient.ClientInfo `json:"cli,omitempty"` // 签发时的客户端信息
+	IssuedAt      int64               `json:"iat"`           // 签发时间
+	ExpiresAt     int64               `json:"exp"`           // 过期时间
+	NotBefore     int64               `json:"nbf"`           // 生效时间
 }
 
 // 主访问令牌声明
@@ -66,18 +96,31 @@ type AccessClaims struct {
 	Role     string `json:"role"`  // 用户角色
 	Exp      int64  `json:"exp"`   // 过期时间
 	Iat      int64  `json:"iat"`   // 签发时间
+	Jti      string `json:"jti"`   // 令牌ID，用于吊销
+}
+
+// 刷新令牌声明
+type RefreshClaims struct {
+	UserID string `json:"uid"` // 用户ID
+	Family string `json:"fam"` // 令牌族，同一次登录轮换出的刷新令牌属于同一族
+	Jti    string `json:"jti"` // 令牌ID，每次轮换都会变化
+	Exp    int64  `json:"exp"` // 过期时间
+	Iat    int64  `json:"iat"` // 签发时间
 }
 
 // SecureJWT 安全JWT管理器
 type SecureJWT struct {
-	accessSecret []byte        // 主令牌密钥
-	examSecret   []byte        // 考试令牌密钥
-	algorithm    Algorithm     // 签名算法
-	accessExpiry time.Duration // 主令牌有效期
-	examExpiry   time.Duration // 考试令牌默认有效期
-	issuer       string        // 签发者
-	audience     string        // 受众
-	sessionStore *SessionStore // 会话存储
+	accessSecret      []byte            // 主令牌密钥
+	examSecret        []byte            // 考试令牌密钥
+	refreshSecret     []byte            // 刷新令牌密钥
+	algorithm         Algorithm         // 签名算法
+	accessExpiry      time.Duration     // 主令牌有效期
+	refreshExpiry     time.Duration     // 刷新令牌有效期
+	examExpiry        time.Duration     // 考试令牌默认有效期
+	issuer            string            // 签发者
+	audience          string            // 受众
+	sessionStore      *SessionStore     // 会话存储
+	fingerprintPolicy FingerprintPolicy // 考试令牌指纹校验策略
 }
 
 // 安全配置选项
@@ -88,12 +131,17 @@ func NewSecureJWT(accessSecret, examSecret string, options ...SecurityOption) *S
 	j := &SecureJWT{
 		accessSecret: []byte(accessSecret),
 		examSecret:   []byte(examSecret),
-		algorithm:    HS256,
-		accessExpiry: 24 * time.Hour, // 主令牌默认24小时
-		examExpiry:   2 * time.Hour,  // 考试令牌默认2小时
-		issuer:       "exam-system",
-		audience:     "exam-client",
-		sessionStore: &SessionStore{sessions: make(map[string]ExamSessionClaims)},
+		// 刷新令牌使用独立密钥，避免与主令牌互相冒用；未通过 WithRefreshSecret 配置时由主令牌密钥派生
+		refreshSecret: []byte(accessSecret + ":refresh"),
+		algorithm:     HS256,
+		accessExpiry:  24 * time.Hour,     // 主令牌默认24小时
+		refreshExpiry: 7 * 24 * time.Hour, // 刷新令牌默认7天
+		examExpiry:    2 * time.Hour,      // 考试令牌默认2小时
+		issuer:        "exam-system",
+		audience:      "exam-client",
+		sessionStore:  &SessionStore{sessions: make(map[string]ExamSessionClaims)},
+		// 默认严格校验客户端指纹
+		fingerprintPolicy: FingerprintStrict,
 	}
 
 	for _, option := range options {
@@ -110,18 +158,37 @@ func WithAlgorithm(alg Algorithm) SecurityOption {
 	}
 }
 
+// WithRefreshSecret 配置刷新令牌密钥，与主令牌密钥分开管理和轮换
+func WithRefreshSecret(secret string) SecurityOption {
+	return func(j *SecureJWT) {
+		j.refreshSecret = []byte(secret)
+	}
+}
+
 func WithAccessExpiry(duration time.Duration) SecurityOption {
 	return func(j *SecureJWT) {
 		j.accessExpiry = duration
 	}
 }
 
+func WithRefreshExpiry(duration time.Duration) SecurityOption {
+	return func(j *SecureJWT) {
+		j.refreshExpiry = duration
+	}
+}
+
 func WithExamExpiry(duration time.Duration) SecurityOption {
 	return func(j *SecureJWT) {
 		j.examExpiry = duration
 	}
 }
 
+func WithFingerprintPolicy(policy FingerprintPolicy) SecurityOption {
+	return func(j *SecureJWT) {
+		j.fingerprintPolicy = policy
+	}
+}
+
 func WithIssuer(issuer string) SecurityOption {
 	return func(j *SecureJWT) {
 		j.issuer = issuer
@@ -147,6 +214,7 @@ func (j *SecureJWT) GenerateAccessToken(userID, username, role string) (string,
 		Role:     role,
 		Iat:      now.Unix(),
 		Exp:      now.Add(j.accessExpiry).Unix(),
+		Jti:      generateJTI(),
 	}
 
 	return j.generateToken(claims, j.accessSecret)
@@ -167,6 +235,46 @@ func (j *SecureJWT) VerifyAccessToken(tokenString string) (*AccessClaims, error)
 	return &claims, nil
 }
 
+// =====================
+// 刷新令牌功能
+// =====================
+
+// 生成刷新令牌，family 为空时开启新的令牌族
+func (j *SecureJWT) GenerateRefreshToken(userID, family string) (string, *RefreshClaims, error) {
+	now := time.Now()
+	if family == "" {
+		family = generateJTI()
+	}
+	claims := RefreshClaims{
+		UserID: userID,
+		Family: family,
+		Jti:    generateJTI(),
+		Iat:    now.Unix(),
+		Exp:    now.Add(j.refreshExpiry).Unix(),
+	}
+
+	token, err := j.generateToken(claims, j.refreshSecret)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &claims, nil
+}
+
+// 验证刷新令牌
+func (j *SecureJWT) VerifyRefreshToken(tokenString string) (*RefreshClaims, error) {
+	var claims RefreshClaims
+	if err := j.parseToken(tokenString, j.refreshSecret, &claims); err != nil {
+		return nil, err
+	}
+
+	// 检查是否过期
+	if time.Now().Unix() > claims.Exp {
+		return nil, ErrTokenExpired
+	}
+
+	return &claims, nil
+}
+
 // =====================
 // 考试令牌功能
 // =====================
@@ -201,6 +309,7 @@ func (j *SecureJWT) GenerateExamToken(
 		AssociationId: AssociationId,
 		UserID:        accessClaims.UserID,
 		ClientFP:      clientFP,
+		Client:        clientInfo,
 		IssuedAt:      now.Unix(),
 		ExpiresAt:     now.Add(expiry).Unix(),
 		NotBefore:     now.Unix(),
@@ -215,32 +324,57 @@ func (j *SecureJWT) GenerateExamToken(
 	return token, &claims, nil
 }
 
-// 验证考试令牌
+// 验证考试令牌。客户端指纹与签发时不一致时返回不一致详情，按策略不放行时同时返回 ErrFingerprintChange
 func (j *SecureJWT) VerifyExamToken(
 	accessToken string,
 	examToken string,
-	clientInfo *iclient.ClientInfo) (*ExamSessionClaims, error) {
+	clientInfo *iclient.ClientInfo) (*ExamSessionClaims, *FingerprintMismatch, error) {
 	// 1. 验证主访问令牌
 	accessClaims, err := j.VerifyAccessToken(accessToken)
 	if err != nil {
-		return nil, fmt.Errorf("access token invalid: %w", err)
+		return nil, nil, fmt.Errorf("access token invalid: %w", err)
 	}
 
 	// 2. 解析考试令牌
 	var examClaims ExamSessionClaims
 	if err := j.parseToken(examToken, j.examSecret, &examClaims); err != nil {
-		return nil, fmt.Errorf("exam token invalid: %w", err)
+		return nil, nil, fmt.Errorf("exam token invalid: %w", err)
 	}
 
 	// 3. 检查是否过期
 	if time.Now().Unix() > examClaims.ExpiresAt {
-		return nil, ErrTokenExpired
+		return nil, nil, ErrTokenExpired
 	}
 	// 4. 检查令牌关联性
 	if examClaims.UserID != accessClaims.UserID {
-		return nil, errors.New("token user mismatch")
+		return nil, nil, errors.New("token user mismatch")
+	}
+	// 5. 检查客户端指纹
+	if clientInfo == nil {
+		return &examClaims, nil, nil
+	}
+	currentFP := j.generateClientFingerprintFromInfo(clientInfo)
+	if examClaims.ClientFP == currentFP {
+		return &examClaims, nil, nil
+	}
+	mismatch := &FingerprintMismatch{
+		Policy:    j.fingerprintPolicy,
+		Original:  examClaims.Client,
+		Current:   clientInfo,
+		CurrentFP: currentFP,
+	}
+	switch j.fingerprintPolicy {
+	case FingerprintLogOnly:
+		mismatch.Allowed = true
+	case FingerprintTolerant:
+		mismatch.Allowed = j.isFingerprintChangeAllowed(examClaims.Client, clientInfo)
+	default:
+		mismatch.Allowed = false
 	}
-	return &examClaims, nil
+	if !mismatch.Allowed {
+		return &examClaims, mismatch, ErrFingerprintChange
+	}
+	return &examClaims, mismatch, nil
 }
 
 // =====================
@@ -396,19 +530,12 @@ func (j *SecureJWT) generateClientFingerprintFromInfo(info *iclient.ClientInfo)
 	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
 }
 
-// 判断指纹变化是否允许
-func (j *SecureJWT) isFingerprintChangeAllowed(original, current string) bool {
-	// 实际应用中应有更复杂的逻辑
-	// 这里简化为只检查前两部分是否一致
-	origParts := strings.Split(original, "|")
-	currParts := strings.Split(current, "|")
-
-	if len(origParts) < 2 || len(currParts) < 2 {
+// 判断指纹变化是否允许：只检查 UserAgent 和平台是否一致
+func (j *SecureJWT) isFingerprintChangeAllowed(original, current *iclient.ClientInfo) bool {
+	if original == nil || current == nil {
 		return false
 	}
-
-	// 检查UserAgent和平台
-	return origParts[0] == currParts[0] && origParts[3] == currParts[3]
+	return original.UserAgent == current.UserAgent && original.Platform == current.Platform
 }
 
 // =====================
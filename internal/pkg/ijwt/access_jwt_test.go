@@ -0,0 +1,107 @@
+package ijwt
+
+import (
+	"testing"
+	"time"
+
+	"exam_api/internal/pkg/iclient"
+)
+
+func TestParseFingerprintPolicy(t *testing.T) {
+	cases := []struct {
+		policy  string
+		want    FingerprintPolicy
+		wantErr bool
+	}{
+		{"", FingerprintStrict, false},
+		{"strict", FingerprintStrict, false},
+		{"tolerant", FingerprintTolerant, false},
+		{"log_only", FingerprintLogOnly, false},
+		{"Strict", "", true},
+		{"logonly", "", true},
+	}
+	for _, c := range cases {
+		got, err := ParseFingerprintPolicy(c.policy)
+		if (err != nil) != c.wantErr {
+			t.Errorf("ParseFingerprintPolicy(%q) err = %v, wantErr %v", c.policy, err, c.wantErr)
+		}
+		if got != c.want {
+			t.Errorf("ParseFingerprintPolicy(%q) = %q, want %q", c.policy, got, c.want)
+		}
+	}
+}
+
+func TestVerifyExamTokenFingerprint(t *testing.T) {
+	issued := &iclient.ClientInfo{UserAgent: "ua", Platform: "mac", AcceptLang: "zh-CN"}
+	cases := []struct {
+		name         string
+		policy       FingerprintPolicy
+		current      *iclient.ClientInfo
+		wantMismatch bool
+		wantAllowed  bool
+	}{
+		{"指纹一致", FingerprintStrict, &iclient.ClientInfo{UserAgent: "ua", Platform: "mac", AcceptLang: "zh-CN"}, false, true},
+		{"严格策略拒绝任意变化", FingerprintStrict, &iclient.ClientInfo{UserAgent: "ua", Platform: "mac", AcceptLang: "en"}, true, false},
+		{"宽松策略放行语言变化", FingerprintTolerant, &iclient.ClientInfo{UserAgent: "ua", Platform: "mac", AcceptLang: "en"}, true, true},
+		{"宽松策略拒绝平台变化", FingerprintTolerant, &iclient.ClientInfo{UserAgent: "ua", Platform: "win", AcceptLang: "zh-CN"}, true, false},
+		{"只记录策略全部放行", FingerprintLogOnly, &iclient.ClientInfo{UserAgent: "other", Platform: "win"}, true, true},
+	}
+	for _, c := range cases {
+		j := NewSecureJWT("access", "exam", WithFingerprintPolicy(c.policy))
+		accessToken, err := j.GenerateAccessToken("EP1", "user", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		examToken, _, err := j.GenerateExamToken(accessToken, "ESPA1", time.Hour, issued)
+		if err != nil {
+			t.Fatal(err)
+		}
+		claims, mismatch, err := j.VerifyExamToken(accessToken, examToken, c.current)
+		if claims == nil {
+			t.Fatalf("%s: claims is nil, err = %v", c.name, err)
+		}
+		if (mismatch != nil) != c.wantMismatch {
+			t.Fatalf("%s: mismatch = %+v, want %v", c.name, mismatch, c.wantMismatch)
+		}
+		if (err == nil) != c.wantAllowed {
+			t.Errorf("%s: err = %v, want allowed %v", c.name, err, c.wantAllowed)
+		}
+		if mismatch != nil {
+			if mismatch.Allowed != c.wantAllowed {
+				t.Errorf("%s: Allowed = %v, want %v", c.name, mismatch.Allowed, c.wantAllowed)
+			}
+			// 同一变化的指纹相同，用于去重
+			if mismatch.CurrentFP != j.generateClientFingerprintFromInfo(c.current) || mismatch.CurrentFP == claims.ClientFP {
+				t.Errorf("%s: CurrentFP = %q", c.name, mismatch.CurrentFP)
+			}
+		}
+	}
+}
+
+func TestRefreshSecret(t *testing.T) {
+	issuer := NewSecureJWT("access", "exam", WithRefreshSecret("refresh"))
+	refreshToken, _, err := issuer.GenerateRefreshToken("EP1", "F1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	accessToken, err := issuer.GenerateAccessToken("EP1", "user", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name     string
+		verifier *SecureJWT
+		token    string
+		wantErr  bool
+	}{
+		{"同一刷新密钥", NewSecureJWT("other", "exam", WithRefreshSecret("refresh")), refreshToken, false},
+		{"主令牌密钥相同但刷新密钥不同", NewSecureJWT("access", "exam", WithRefreshSecret("rotated")), refreshToken, true},
+		{"未配置刷新密钥时不认配置过的刷新令牌", NewSecureJWT("access", "exam"), refreshToken, true},
+		{"主令牌不能当作刷新令牌", issuer, accessToken, true},
+	}
+	for _, c := range cases {
+		if _, err := c.verifier.VerifyRefreshToken(c.token); (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package istorage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Storage 对象存储：为存储 key 生成带签名、会过期的访问地址
+type Storage interface {
+	SignURL(key string, expire time.Duration) (signedURL string, expiresAt time.Time, err error)
+}
+
+// LocalPrefix 本地存储的访问路径前缀
+const LocalPrefix = "/media/"
+
+// Local 本地文件存储，用于开发和测试：签名地址由本服务校验签名后直接返回文件
+type Local struct {
+	root   string
+	domain string
+	secret []byte
+}
+
+func NewLocal(root, domain, secret string) (*Local, error) {
+	if root == "" || secret == "" {
+		return nil, errors.New("local storage requires root and secret")
+	}
+	return &Local{root: root, domain: strings.TrimRight(domain, "/"), secret: []byte(secret)}, nil
+}
+
+func (s *Local) SignURL(key string, expire time.Duration) (signedURL string, expiresAt time.Time, err error) {
+	key = cleanKey(key)
+	if key == "" {
+		err = errors.New("empty storage key")
+		return
+	}
+	expiresAt = time.Now().Add(expire)
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, expires))
+	signedURL = s.domain + LocalPrefix + (&url.URL{Path: key}).EscapedPath() + "?" + query.Encode()
+	return
+}
+
+// ServeHTTP 校验签名和过期时间后返回文件
+func (s *Local) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := cleanKey(strings.TrimPrefix(r.URL.Path, LocalPrefix))
+	expires := r.URL.Query().Get("expires")
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if key == "" || err != nil || time.Now().Unix() > unix {
+		http.Error(w, "link expired", http.StatusForbidden)
+		return
+	}
+	if !hmac.Equal([]byte(s.sign(key, expires)), []byte(r.URL.Query().Get("signature"))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	http.ServeFile(w, r, filepath.Join(s.root, filepath.FromSlash(key)))
+}
+
+func (s *Local) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// cleanKey 规范化存储 key，去掉开头的 / 和 ..，避免访问根目录之外的文件
+func cleanKey(key string) string {
+	return strings.TrimPrefix(path.Clean("/"+key), "/")
+}
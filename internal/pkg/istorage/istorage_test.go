@@ -0,0 +1,97 @@
+package istorage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalSignURL(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "q"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "q", "a.png"), []byte("png"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	local, err := NewLocal(root, "http://oss.example.com/", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, expiresAt, err := local.SignURL("/q/a.png", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(signed, "http://oss.example.com"+LocalPrefix+"q/a.png?") {
+		t.Fatalf("signed url = %q", signed)
+	}
+	if d := time.Until(expiresAt); d <= 0 || d > time.Minute {
+		t.Fatalf("expiresAt = %v", expiresAt)
+	}
+	u, _ := url.Parse(signed)
+	query := u.Query()
+	tamper := func(key, value string) string {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set(key, value)
+		return q.Encode()
+	}
+	cases := []struct {
+		name   string
+		path   string
+		query  string
+		status int
+	}{
+		{"签名正确", u.Path, u.RawQuery, http.StatusOK},
+		{"篡改文件路径", LocalPrefix + "q/b.png", u.RawQuery, http.StatusForbidden},
+		{"篡改过期时间", u.Path, tamper("expires", "9999999999"), http.StatusForbidden},
+		{"篡改签名", u.Path, tamper("signature", "00"), http.StatusForbidden},
+		{"已过期", u.Path, tamper("expires", "1"), http.StatusForbidden},
+		{"缺少签名", u.Path, "", http.StatusForbidden},
+	}
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		local.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, c.path+"?"+c.query, nil))
+		if rec.Code != c.status {
+			t.Errorf("%s: status = %d, want %d", c.name, rec.Code, c.status)
+		}
+	}
+}
+
+func TestLocalRejectsInvalidConfig(t *testing.T) {
+	cases := []struct {
+		name, root, secret string
+	}{
+		{"缺少根目录", "", "secret"},
+		{"缺少签名密钥", "/tmp", ""},
+	}
+	for _, c := range cases {
+		if _, err := NewLocal(c.root, "", c.secret); err == nil {
+			t.Errorf("%s: want error", c.name)
+		}
+	}
+}
+
+func TestCleanKey(t *testing.T) {
+	cases := []struct {
+		key, want string
+	}{
+		{"q/a.png", "q/a.png"},
+		{"/q/a.png", "q/a.png"},
+		{"../../etc/passwd", "etc/passwd"},
+		{"q/../../a.png", "a.png"},
+		{"", ""},
+	}
+	for _, c := range cases {
+		if got := cleanKey(c.key); got != c.want {
+			t.Errorf("cleanKey(%q) = %q, want %q", c.key, got, c.want)
+		}
+	}
+}
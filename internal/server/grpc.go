@@ -2,6 +2,7 @@ package server
 
 import (
 	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/biz"
 	"exam_api/internal/conf"
 	"exam_api/internal/middleware"
 	"exam_api/internal/service"
@@ -12,12 +13,12 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, exam *service.ExamService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, exam *service.ExamService, loginUc *biz.LoginUseCase, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			middleware.RequestIdWithHeader,
-			middleware.TryParseHeader(),
+			middleware.TryParseHeader(middleware.WithTokenRevocation(loginUc)),
 			validate.Validator(),
 		),
 	}
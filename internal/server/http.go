@@ -2,14 +2,17 @@ package server
 
 import (
 	v1 "exam_api/api/exam_api/v1"
+	"exam_api/internal/biz"
 	"exam_api/internal/conf"
 	"exam_api/internal/middleware"
 	"exam_api/internal/pkg/ilog"
+	"exam_api/internal/pkg/istorage"
 	"exam_api/internal/service"
 	"github.com/airunny/wiki-go-tools/env"
 	"github.com/go-kratos/grpc-gateway/v2/protoc-gen-openapiv2/generator"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	stdhttp "net/http"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -18,15 +21,23 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, exam *service.ExamService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, exam *service.ExamService, loginUc *biz.LoginUseCase, examineeAnswerUc *biz.ExamineeAnswerUseCase, storage biz.StorageRepo, logger log.Logger) (*http.Server, error) {
 	serviceName := env.GetServiceName()
+	proxies, err := middleware.ParseTrustedProxies(c.Http.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	var opts = []http.ServerOption{
 		http.Filter(middleware.CORS(), ilog.LoggingHandler(serviceName, ilog.WithAccessLog())),
 		http.Middleware(
 			recovery.Recovery(),
 			middleware.RequestIdWithHeader,
-			middleware.TryParseHeader(),
-			middleware.AuthExamTokenMiddleware(),
+			middleware.TryParseHeader(middleware.WithTokenRevocation(loginUc)),
+			middleware.AuthExamTokenMiddleware(
+				middleware.WithTrustedProxies(proxies),
+				middleware.WithFingerprintRecorder(examineeAnswerUc),
+				middleware.WithExamSessionChecker(examineeAnswerUc),
+			),
 			validate.Validator(),
 		),
 		http.ErrorEncoder(middleware.ErrorEncoder),
@@ -49,7 +60,11 @@ func NewHTTPServer(c *conf.Server, exam *service.ExamService, logger log.Logger)
 		generator.EnumsAsInts(true),
 	))
 	srv.HandlePrefix("/q/", openAPIHandler)
+	// 本地存储由本服务提供附件访问
+	if h, ok := storage.(stdhttp.Handler); ok {
+		srv.HandlePrefix(istorage.LocalPrefix, h)
+	}
 
 	srv.Handle("/metrics", promhttp.Handler())
-	return srv
+	return srv, nil
 }
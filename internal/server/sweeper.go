@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"exam_api/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// sweepInterval 扫描间隔
+	sweepInterval = 30 * time.Second
+	// sweepLease 多实例间的租约时长，扫描结束即释放，只在实例异常退出时等待过期
+	sweepLease = 5 * time.Minute
+)
+
+// SweeperServer 后台定时扫描时间用完或过了截止时间的考试，并对算分未完成或失败的考试重新算分，随 kratos 应用一起启动和停止
+type SweeperServer struct {
+	examineeAnswerUc *biz.ExamineeAnswerUseCase
+	log              *log.Helper
+	cancel           context.CancelFunc
+	done             chan struct{}
+}
+
+// NewSweeperServer new a sweeper server.
+func NewSweeperServer(examineeAnswerUc *biz.ExamineeAnswerUseCase, logger log.Logger) *SweeperServer {
+	return &SweeperServer{
+		examineeAnswerUc: examineeAnswerUc,
+		log:              log.NewHelper(logger),
+	}
+}
+
+func (s *SweeperServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	defer close(s.done)
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.examineeAnswerUc.Sweep(ctx, sweepLease); err != nil {
+				s.log.WithContext(ctx).Errorf("SweeperServer.Sweep Failed, err:%v", err.Error())
+			}
+		}
+	}
+}
+
+func (s *SweeperServer) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
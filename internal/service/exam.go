@@ -10,7 +10,7 @@ func (s *ExamService) GetExamPageList(ctx context.Context, in *v1.GetExamPageLis
 }
 
 func (s *ExamService) ExamQuestion(ctx context.Context, in *v1.ExamQuestionRequest) (*v1.ExamQuestionResponse, error) {
-	return s.examineeSalesPaperAssociationUc.ExamQuestion(ctx, in)
+	return s.examineeAnswerUseCase.ExamQuestion(ctx, in)
 }
 
 func (s *ExamService) StartExam(ctx context.Context, in *v1.StartExamRequest) (*v1.StartExamResponse, error) {
@@ -25,6 +25,34 @@ func (s *ExamService) SubmitExam(ctx context.Context, in *v1.SubmitExamRequest)
 	return s.examineeAnswerUseCase.SubmitExam(ctx, in)
 }
 
+func (s *ExamService) NextQuestion(ctx context.Context, in *v1.NextQuestionRequest) (*v1.NextQuestionResponse, error) {
+	return s.examineeAnswerUseCase.NextQuestion(ctx, in)
+}
+
+func (s *ExamService) SubmitSection(ctx context.Context, in *v1.SubmitSectionRequest) (*v1.SubmitSectionResponse, error) {
+	return s.examineeAnswerUseCase.SubmitSection(ctx, in)
+}
+
+func (s *ExamService) ReportExamEvents(ctx context.Context, in *v1.ReportExamEventsRequest) (*v1.ReportExamEventsResponse, error) {
+	return s.examineeAnswerUseCase.ReportExamEvents(ctx, in)
+}
+
 func (s *ExamService) ExamQuestionRecord(ctx context.Context, in *v1.ExamQuestionRecordRequest) (*v1.ExamQuestionRecordResponse, error) {
 	return s.examineeAnswerUseCase.ExamQuestionRecord(ctx, in)
 }
+
+func (s *ExamService) ExamAnswerTimeline(ctx context.Context, in *v1.ExamAnswerTimelineRequest) (*v1.ExamAnswerTimelineResponse, error) {
+	return s.examineeAnswerUseCase.ExamAnswerTimeline(ctx, in)
+}
+
+func (s *ExamService) GetGradingQueue(ctx context.Context, in *v1.GetGradingQueueRequest) (*v1.GetGradingQueueResponse, error) {
+	return s.examineeAnswerScoreUc.GetGradingQueue(ctx, in)
+}
+
+func (s *ExamService) GradeAnswer(ctx context.Context, in *v1.GradeAnswerRequest) (*v1.GradeAnswerResponse, error) {
+	return s.examineeAnswerScoreUc.GradeAnswer(ctx, in)
+}
+
+func (s *ExamService) GetExamResult(ctx context.Context, in *v1.GetExamResultRequest) (*v1.GetExamResultResponse, error) {
+	return s.examineeAnswerScoreUc.GetExamResult(ctx, in)
+}
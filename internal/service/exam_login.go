@@ -8,3 +8,19 @@ import (
 func (s *ExamService) ExamLogin(ctx context.Context, in *v1.ExamLoginRequest) (*v1.ExamLoginResponse, error) {
 	return s.loginUc.ExamLogin(ctx, in)
 }
+
+func (s *ExamService) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error) {
+	return s.loginUc.RefreshToken(ctx, in)
+}
+
+func (s *ExamService) Logout(ctx context.Context, in *v1.LogoutRequest) (*v1.LogoutResponse, error) {
+	return s.loginUc.Logout(ctx, in)
+}
+
+func (s *ExamService) RequestLoginCode(ctx context.Context, in *v1.RequestLoginCodeRequest) (*v1.RequestLoginCodeResponse, error) {
+	return s.loginUc.RequestLoginCode(ctx, in)
+}
+
+func (s *ExamService) VerifyLoginCode(ctx context.Context, in *v1.VerifyLoginCodeRequest) (*v1.VerifyLoginCodeResponse, error) {
+	return s.loginUc.VerifyLoginCode(ctx, in)
+}
@@ -12,18 +12,21 @@ type ExamService struct {
 	questionUc                      *biz.QuestionUseCase
 	salesPaperUseCase               *biz.SalesPaperUseCase
 	examineeAnswerUseCase           *biz.ExamineeAnswerUseCase
+	examineeAnswerScoreUc           *biz.ExamineeAnswerScoreUseCase
 }
 
 func NewExamService(loginUc *biz.LoginUseCase,
 	examineeSalesPaperAssociationUc *biz.ExamineeSalesPaperAssociationUseCase,
 	questionUc *biz.QuestionUseCase,
 	salesPaperUseCase *biz.SalesPaperUseCase,
-	examineeAnswerUseCase *biz.ExamineeAnswerUseCase) *ExamService {
+	examineeAnswerUseCase *biz.ExamineeAnswerUseCase,
+	examineeAnswerScoreUc *biz.ExamineeAnswerScoreUseCase) *ExamService {
 	return &ExamService{
 		loginUc:                         loginUc,
 		examineeSalesPaperAssociationUc: examineeSalesPaperAssociationUc,
 		questionUc:                      questionUc,
 		salesPaperUseCase:               salesPaperUseCase,
 		examineeAnswerUseCase:           examineeAnswerUseCase,
+		examineeAnswerScoreUc:           examineeAnswerScoreUc,
 	}
 }